@@ -0,0 +1,330 @@
+package asmfmt
+
+import (
+	"bufio"
+	"bytes"
+	"go/build/constraint"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+//go:generate go run mkmnemonics.go
+
+// knownArch contains the values of GOARCH known to Go.
+var knownArch = map[string]bool{
+	"386":         true,
+	"amd64":       true,
+	"amd64p32":    true,
+	"arm":         true,
+	"armbe":       true,
+	"arm64":       true,
+	"arm64be":     true,
+	"loong64":     true,
+	"mips":        true,
+	"mipsle":      true,
+	"mips64":      true,
+	"mips64le":    true,
+	"mips64p32":   true,
+	"mips64p32le": true,
+	"ppc":         true,
+	"ppc64":       true,
+	"ppc64le":     true,
+	"riscv":       true,
+	"riscv64":     true,
+	"s390":        true,
+	"s390x":       true,
+	"sparc":       true,
+	"sparc64":     true,
+	"wasm":        true,
+}
+
+// archFamily contains architectures that only differ in byte order,
+// and the architecture used for the family.
+// Files for a family often use the family name with an "x" suffix, like "_ppc64x.s".
+var archFamily = map[string]string{
+	"mipsle":   "mips",
+	"mips64le": "mips64",
+	"ppc64le":  "ppc64",
+}
+
+// InferArch returns the GOARCH of an assembler file, from the suffix
+// of the filename, like "_arm64.s", or from the build constraints in
+// the comments at the start of the file.
+// If the file is for both architectures of a family, like ppc64 and
+// ppc64le, the first of the family is returned.
+// If the architecture cannot be determined, an empty string is returned.
+func InferArch(filename string, src []byte) string {
+	if arch := fileArch(filename); arch != "" {
+		return arch
+	}
+	// Like when formatting, blank lines and block comments
+	// before the first statement are skipped.
+	var lines []string
+	inBlock := false
+	s := bufio.NewScanner(bytes.NewReader(src))
+	s.Buffer(nil, len(src)+1)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if !inBlock && strings.HasPrefix(line, "/*") {
+			line, inBlock = line[2:], true
+		}
+		if inBlock {
+			i := strings.Index(line, "*/")
+			if i < 0 {
+				continue
+			}
+			line, inBlock = strings.TrimSpace(line[i+2:]), false
+			if line != "" {
+				// Content after the comment is a statement.
+				break
+			}
+		}
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, "//") {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return constraintArch(lines)
+}
+
+// fileArch returns the architecture given by the suffix of the filename,
+// using the same rules as the go command, or "" if there is none.
+func fileArch(filename string) string {
+	name := filepath.Base(filename)
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[:i]
+	}
+	// Like the go command, the part before the first "_" is ignored,
+	// so "amd64.s" is not limited to amd64.
+	i := strings.Index(name, "_")
+	if i < 0 {
+		return ""
+	}
+	elems := strings.Split(name[i:], "_")
+	last := elems[len(elems)-1]
+	if knownArch[last] {
+		return last
+	}
+	// Files for a family, like "_ppc64x.s".
+	if base := strings.TrimSuffix(last, "x"); base != last {
+		for _, family := range archFamily {
+			if family == base {
+				return base
+			}
+		}
+	}
+	return ""
+}
+
+// constraintArch returns the architecture allowed by the build constraint
+// comment lines, or "" if none or several architectures are allowed.
+// A //go:build line takes precedence over // +build lines.
+func constraintArch(lines []string) string {
+	var expr constraint.Expr
+	for _, line := range lines {
+		if constraint.IsGoBuild(line) {
+			x, err := constraint.Parse(line)
+			if err == nil {
+				expr = x
+				break
+			}
+		}
+		if !constraint.IsPlusBuild(line) {
+			continue
+		}
+		x, err := constraint.Parse(line)
+		if err != nil {
+			continue
+		}
+		if expr == nil {
+			expr = x
+		} else {
+			expr = &constraint.AndExpr{X: expr, Y: x}
+		}
+	}
+	if expr == nil {
+		return ""
+	}
+
+	res := ""
+	for arch := range knownArch {
+		if possible, _ := evalArch(expr, arch); !possible {
+			continue
+		}
+		if family, ok := archFamily[arch]; ok {
+			arch = family
+		}
+		if res != "" && res != arch {
+			return ""
+		}
+		res = arch
+	}
+	return res
+}
+
+// evalArch evaluates the constraint when building for arch.
+// Tags other than architectures may be either true or false,
+// so it is returned whether the constraint can be true and whether it can be false.
+func evalArch(x constraint.Expr, arch string) (canTrue, canFalse bool) {
+	switch x := x.(type) {
+	case *constraint.TagExpr:
+		if !knownArch[x.Tag] {
+			return true, true
+		}
+		return x.Tag == arch, x.Tag != arch
+	case *constraint.NotExpr:
+		t, f := evalArch(x.X, arch)
+		return f, t
+	case *constraint.AndExpr:
+		xt, xf := evalArch(x.X, arch)
+		yt, yf := evalArch(x.Y, arch)
+		return xt && yt, xf || yf
+	case *constraint.OrExpr:
+		xt, xf := evalArch(x.X, arch)
+		yt, yf := evalArch(x.Y, arch)
+		return xt || yt, xf && yf
+	}
+	return true, true
+}
+
+// x86Terminators are the terminators of 386 and amd64.
+var x86Terminators = map[string]bool{
+	"IRETL": true, "IRETQ": true, "IRETW": true,
+	"RETFL": true, "RETFQ": true, "RETFW": true,
+	"SYSRET": true, "UD1": true, "UD2": true,
+}
+
+var mipsTerminators = map[string]bool{"RFE": true}
+
+var ppc64Terminators = map[string]bool{
+	"BR": true, "HRFID": true, "RFCI": true, "RFI": true, "RFID": true,
+}
+
+// archTerminators contains the instructions of each architecture
+// that never continue with the next instruction:
+// unconditional branches, returns, tail calls and traps.
+// Calls, like CALL and BL, return to the next instruction,
+// so they are not terminators, unlike B and JMP.
+// JMP, RET and UNDEF are terminators on all architectures.
+// The instructions are upper case.
+var archTerminators = map[string]map[string]bool{
+	"386":      x86Terminators,
+	"amd64":    x86Terminators,
+	"amd64p32": x86Terminators,
+	"arm":      {"B": true, "BX": true, "RFE": true},
+	"arm64":    {"B": true, "ERET": true},
+	"loong64":  {"RFE": true},
+	"mips":     mipsTerminators,
+	"mipsle":   mipsTerminators,
+	"mips64":   mipsTerminators,
+	"mips64le": mipsTerminators,
+	"ppc64":    ppc64Terminators,
+	"ppc64le":  ppc64Terminators,
+	"riscv64":  {"MRET": true, "SRET": true},
+	"s390x":    {"BR": true},
+	"wasm":     {"BR": true, "BRTABLE": true, "RETUNWIND": true, "RETURN": true, "UNREACHABLE": true},
+}
+
+// isTerminator returns true if execution never continues
+// after the upper case instruction with the parameters on arch.
+func isTerminator(arch, instruction string, params []string) bool {
+	switch instruction {
+	case "JMP", "RET", "UNDEF":
+		return true
+	case "JAL", "JALR":
+		// Jumps that don't save the return address.
+		if arch == "riscv64" && len(params) > 1 {
+			link := strings.ToUpper(params[0])
+			return link == "ZERO" || link == "X0"
+		}
+	}
+	return archTerminators[arch][instruction]
+}
+
+// mnemonicArch contains the table in mnemonicTables of each architecture.
+var mnemonicArch = map[string]string{
+	"386":      "x86",
+	"amd64":    "x86",
+	"amd64p32": "x86",
+	"arm":      "arm",
+	"arm64":    "arm64",
+	"loong64":  "loong64",
+	"mips":     "mips",
+	"mipsle":   "mips",
+	"mips64":   "mips",
+	"mips64le": "mips",
+	"ppc64":    "ppc64",
+	"ppc64le":  "ppc64",
+	"riscv64":  "riscv64",
+	"s390x":    "s390x",
+	"wasm":     "wasm",
+}
+
+// mnemonicSet contains the mnemonics of a table.
+type mnemonicSet struct {
+	exact map[string]bool
+	// upper maps the upper case mnemonic to the mnemonic.
+	// If several mnemonics have the same upper case, like "Call"
+	// and "CALL" on wasm, it maps to "".
+	upper map[string]string
+}
+
+func (m *mnemonicSet) add(s string) {
+	m.exact[s] = true
+	up := strings.ToUpper(s)
+	if prev, ok := m.upper[up]; ok && prev != s {
+		s = ""
+	}
+	m.upper[up] = s
+}
+
+var (
+	mnemonicsOnce sync.Once
+
+	// mnemonics contains the mnemonics of each table.
+	// The "" table contains the mnemonics of all tables except wasm,
+	// which has mixed case mnemonics.
+	mnemonics map[string]*mnemonicSet
+)
+
+// mnemonic returns the instruction or directive with the case used by
+// the Go assembler for arch, or "" if it is not known.
+// Suffixes after a dot, like ".P" on arm64, are upper case.
+// If arch has no table, the instructions of all architectures except wasm are used.
+func mnemonic(arch, instruction string) string {
+	mnemonicsOnce.Do(func() {
+		newSet := func() *mnemonicSet {
+			return &mnemonicSet{exact: make(map[string]bool), upper: make(map[string]string)}
+		}
+		mnemonics = map[string]*mnemonicSet{"": newSet()}
+		for name, table := range mnemonicTables {
+			m := newSet()
+			for _, s := range strings.Fields(table) {
+				m.add(s)
+				if name != "wasm" {
+					mnemonics[""].add(s)
+				}
+			}
+			mnemonics[name] = m
+		}
+	})
+	m := mnemonics[mnemonicArch[arch]]
+	if m == nil {
+		m = mnemonics[""]
+	}
+	suffix := ""
+	if i := strings.Index(instruction, "."); i > 0 && arch != "wasm" {
+		instruction, suffix = instruction[:i], strings.ToUpper(instruction[i:])
+	}
+	if m.exact[instruction] {
+		return instruction + suffix
+	}
+	if s := m.upper[strings.ToUpper(instruction)]; s != "" {
+		return s + suffix
+	}
+	return ""
+}
@@ -0,0 +1,96 @@
+package asmfmt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestInferArch(t *testing.T) {
+	tests := []struct {
+		filename, src, want string
+	}{
+		{filename: "asm_amd64.s", want: "amd64"},
+		{filename: "dir/asm_linux_arm64.s", want: "arm64"},
+		{filename: "sys_linux.s", want: ""},
+		{filename: "amd64.s", want: ""},
+		{filename: "memmove_ppc64x.s", want: "ppc64"},
+		{filename: "asm_mips64x.s", want: "mips64"},
+		{filename: "asm_386.s", src: "//go:build amd64\n", want: "386"},
+		{src: "//go:build amd64 && !purego\n\nTEXT ·x(SB), $0\n", want: "amd64"},
+		{src: "// Copyright\n\n//go:build (linux || darwin) && arm64\n// +build linux darwin\n// +build arm64\n", want: "arm64"},
+		{src: "// +build linux darwin\n// +build riscv64\n", want: "riscv64"},
+		{src: "//go:build ppc64 || ppc64le\n", want: "ppc64"},
+		{src: "//go:build amd64 || arm64\n", want: ""},
+		{src: "//go:build !amd64\n", want: ""},
+		{src: "//go:build linux\n", want: ""},
+		{src: "TEXT ·x(SB), $0\n//go:build amd64\n", want: ""},
+		{src: "/*\n//go:build amd64\n*/\n", want: ""},
+		{src: "/*\n * Copyright\n */\n\n//go:build arm64\n\nTEXT ·x(SB), $0\n", want: "arm64"},
+		{src: "/* Copyright */\n// +build s390x\n", want: "s390x"},
+		{src: "/* Copyright */ TEXT ·x(SB), $0\n//go:build arm64\n", want: ""},
+	}
+	for _, test := range tests {
+		if got := InferArch(test.filename, []byte(test.src)); got != test.want {
+			t.Errorf("%q, %q: got %q, want %q", test.filename, test.src, got, test.want)
+		}
+		if test.filename != "" {
+			continue
+		}
+		// The formatter must infer the same architecture
+		// at the first statement.
+		state := fstate{out: &bytes.Buffer{}}
+		if err := parse(strings.NewReader(test.src+"RET\n"), &Options{}, state.addNode); err != nil {
+			t.Fatal(err)
+		}
+		if state.arch != test.want {
+			t.Errorf("%q: formatter got %q, want %q", test.src, state.arch, test.want)
+		}
+	}
+}
+
+func TestIsTerminator(t *testing.T) {
+	tests := []struct {
+		arch, instruction string
+		params            []string
+		want              bool
+	}{
+		{arch: "amd64", instruction: "JMP", want: true},
+		{arch: "amd64", instruction: "CALL", want: false},
+		{arch: "arm", instruction: "B", want: true},
+		{arch: "arm", instruction: "BX", want: true},
+		{arch: "arm", instruction: "BL", want: false},
+		{arch: "arm64", instruction: "B", want: true},
+		{arch: "arm64", instruction: "BL", want: false},
+		{arch: "riscv64", instruction: "JAL", params: []string{"ZERO", "loop"}, want: true},
+		{arch: "riscv64", instruction: "JAL", params: []string{"RA", "·f(SB)"}, want: false},
+		{instruction: "RET", want: true},
+		{instruction: "B", want: false},
+	}
+	for _, test := range tests {
+		if got := isTerminator(test.arch, test.instruction, test.params); got != test.want {
+			t.Errorf("%s: %s %v: got %v, want %v", test.arch, test.instruction, test.params, got, test.want)
+		}
+	}
+}
+
+func TestFormatArch(t *testing.T) {
+	tests := []struct {
+		opts      Options
+		src, want string
+	}{
+		{src: "//go:build arm64\n\nTEXT ·x(SB), $0\n\tRET\n", want: "arm64"},
+		{opts: Options{Filename: "x_386.s"}, src: "//go:build arm64\nTEXT ·x(SB), $0\n", want: "386"},
+		{opts: Options{Arch: "s390x", Filename: "x_386.s"}, src: "TEXT ·x(SB), $0\n", want: "s390x"},
+		{src: "TEXT ·x(SB), $0\n//go:build arm64\n", want: ""},
+	}
+	for _, test := range tests {
+		state := fstate{out: &bytes.Buffer{}, opts: test.opts, arch: test.opts.arch()}
+		if err := parse(strings.NewReader(test.src), &test.opts, state.addNode); err != nil {
+			t.Fatal(err)
+		}
+		if state.arch != test.want {
+			t.Errorf("%+v, %q: got %q, want %q", test.opts, test.src, state.arch, test.want)
+		}
+	}
+}
@@ -1,257 +1,324 @@
 package asmfmt
 
 import (
-	"bufio"
 	"bytes"
 	"fmt"
 	"io"
 	"strings"
 	"unicode"
+
+	"github.com/klauspost/asmfmt/lexer"
 )
 
 // Format the input and return the formatted data.
 // If any error is encountered, no data will be returned.
 func Format(in io.Reader) ([]byte, error) {
-	src := bufio.NewReaderSize(in, 512<<10)
+	return FormatWithOptions(in, Options{})
+}
+
+// FormatWithOptions formats the input using the supplied options
+// and returns the formatted data.
+// If any error is encountered, no data will be returned.
+func FormatWithOptions(in io.Reader, opts Options) (res []byte, err error) {
+	defer recoverError(opts.Filename, &err)
 	dst := &bytes.Buffer{}
-	state := fstate{out: dst, defines: make(map[string]struct{})}
-	for {
-		data, _, err := src.ReadLine()
-		if err == io.EOF {
-			state.flush()
-			break
-		}
-		if err != nil {
+	state := fstate{out: dst, opts: opts, arch: opts.arch()}
+	err = parse(in, &opts, state.addNode)
+	if err != nil {
+		return nil, err
+	}
+	state.flush()
+	if opts.SelfCheck {
+		if err := selfCheck(dst.Bytes(), opts); err != nil {
 			return nil, err
 		}
-		err = state.addLine(data)
+	}
+	return dst.Bytes(), nil
+}
+
+// FormatTo formats the input and writes the result to w.
+// Each block is written as soon as it is complete, so the memory used
+// is bounded by the largest block rather than the size of the input.
+// Errors in the input are returned when all input has been read,
+// so part of the output may have been written when an error is returned.
+func FormatTo(w io.Writer, in io.Reader) error {
+	return FormatToWithOptions(w, in, Options{})
+}
+
+// FormatToWithOptions formats the input using the supplied options
+// and writes the result to w like FormatTo.
+// If SelfCheck is set, the output can only be checked when it is complete,
+// so nothing is written until all input has been formatted.
+func FormatToWithOptions(w io.Writer, in io.Reader, opts Options) (err error) {
+	defer recoverError(opts.Filename, &err)
+	if opts.SelfCheck {
+		res, err := FormatWithOptions(in, opts)
 		if err != nil {
-			return nil, err
+			return err
 		}
+		_, err = w.Write(res)
+		return err
 	}
-	return dst.Bytes(), nil
+	dst := &bytes.Buffer{}
+	state := fstate{out: dst, opts: opts, arch: opts.arch()}
+	var werr error
+	write := func() {
+		if werr == nil && dst.Len() > 0 {
+			_, werr = w.Write(dst.Bytes())
+		}
+		dst.Reset()
+	}
+	err = parse(in, &opts, func(n Node) {
+		state.addNode(n)
+		write()
+	})
+	if err != nil {
+		return err
+	}
+	state.flush()
+	write()
+	return werr
+}
+
+// Fprint formats the parsed file and writes the result to w.
+// Nodes can be added, removed or modified before printing.
+// The position of added nodes can be left empty.
+func Fprint(w io.Writer, f *File) error {
+	return FprintWithOptions(w, f, Options{})
+}
+
+// FprintWithOptions formats the parsed file using the supplied options
+// and writes the result to w.
+func FprintWithOptions(w io.Writer, f *File, opts Options) (err error) {
+	defer recoverError(opts.Filename, &err)
+	dst := &bytes.Buffer{}
+	state := fstate{out: dst, opts: opts, arch: opts.arch()}
+	for _, n := range f.Nodes {
+		state.addNode(n)
+	}
+	state.flush()
+	if opts.SelfCheck {
+		if err := selfCheck(dst.Bytes(), opts); err != nil {
+			return err
+		}
+	}
+	_, err = w.Write(dst.Bytes())
+	return err
 }
 
 type fstate struct {
 	out           *bytes.Buffer
-	insideBlock   bool // Block comment
-	indentation   int  // Indentation level
-	lastEmpty     bool
+	opts          Options
+	indentation   int // Indentation level
+	emptyLines    int // Number of empty lines just written
 	lastComment   bool
-	lastStar      bool // Block comment, last line started with a star.
 	lastLabel     bool
 	anyContents   bool
 	lastContinued bool // Last line continued
+	inDefine      bool // Last line continued a #define
 	queued        []statement
 	comments      []string
-	defines       map[string]struct{}
+	commentSrc    []int // Source lines of the queued comments.
+
+	// arch is the GOARCH of the input, or "" if not known.
+	// If not given by the options, it is inferred from the build constraints
+	// in the comments before the first statement.
+	arch        string
+	header      []string // Comments before the first statement.
+	afterHeader bool
+
+	// If trackLines is set, the source line of each output line
+	// is added to srcLines. Lines added by the formatter have source line 0.
+	trackLines bool
+	srcLines   []int
 }
 
 type statement struct {
-	instruction string
-	params      []string // Parameters
-	comment     string   // Without slashes
-	function    bool     // Probably define call
-	continued   bool     // Multiline statement, continues on next line
-	contComment bool     // Multiline statement, comment only
-}
-
-// Add a new input line.
-// Since you are looking at ths code:
-// This code has grown over a considerable amount of time,
-// and deserves a rewrite with proper parsing instead of this hodgepodge.
-// Its output is stable, and could be used as reference for a rewrite.
-func (f *fstate) addLine(b []byte) error {
-	if bytes.Contains(b, []byte{0}) {
-		return fmt.Errorf("zero (0) byte in input. file is unlikely an assembler file")
-	}
-	s := string(b)
-	// Inside block comment
-	if f.insideBlock {
-		defer func() {
-			f.lastComment = true
-		}()
-		if strings.Contains(s, "*/") {
-			ends := strings.Index(s, "*/")
-			end := s[:ends]
-			if strings.HasPrefix(strings.TrimSpace(s), "*") && f.lastStar {
-				end = strings.TrimSpace(end) + " "
-			}
-			end = end + "*/"
-			f.insideBlock = false
-			s = strings.TrimSpace(s[ends+2:])
-			if strings.HasSuffix(s, "\\") {
-				end = end + " \\"
-				if len(s) == 1 {
-					s = ""
-				}
-			}
-			f.out.WriteString(end + "\n")
-			if len(s) == 0 {
-				return nil
-			}
-		} else {
-			// Insert a space on lines that begin with '*'
-			if strings.HasPrefix(strings.TrimSpace(s), "*") {
-				s = strings.TrimSpace(s)
-				f.out.WriteByte(' ')
-				f.lastStar = true
-			} else {
-				f.lastStar = false
-			}
-			fmt.Fprintln(f.out, s)
-			return nil
+	instruction  string
+	params       []string // Parameters
+	comment      string   // Without slashes
+	function     bool     // Probably define call
+	macro        bool     // Instruction contains the entire statement
+	blockComment bool     // Comment was a block comment
+	continued    bool     // Multiline statement, continues on next line
+	contComment  bool     // Multiline statement, comment only
+	line         int      // Source line
+}
+
+// addNode adds a parsed node to the output.
+func (f *fstate) addNode(n Node) {
+	switch n := n.(type) {
+	case *Func:
+		f.addNode(n.Text)
+		for _, b := range n.Body {
+			f.addNode(b)
 		}
+	case *Stmt:
+		st := n.statement()
+		st.line = n.Position.Line
+		f.addStatement(st)
+	case *Comment:
+		f.addComment(n)
+	case *BlockComment:
+		f.addBlockComment(n)
+	case *Blank:
+		f.addBlank(n.Position.Line)
 	}
-	s = strings.TrimSpace(s)
+}
 
-	// Comment is the the only line content.
-	if strings.HasPrefix(s, "//") {
-		// Non-comment content is now added.
-		defer func() {
-			f.anyContents = true
-			f.lastEmpty = false
-			f.lastStar = false
-		}()
-
-		s = strings.TrimPrefix(s, "//")
-		if len(f.queued) > 0 {
-			f.flush()
-		}
-		// Newline before comments
-		if len(f.comments) == 0 {
-			f.newLine()
-		}
+// addComment adds a comment on a separate line.
+func (f *fstate) addComment(c *Comment) {
+	// Non-comment content is now added.
+	defer func() {
+		f.anyContents = true
+		f.emptyLines = 0
+	}()
 
-		// Preserve whitespace if the first character after the comment
-		// is a whitespace
-		ts := strings.TrimSpace(s)
-		var q string
-		if (ts != s && len(ts) > 0) || (len(s) > 0 && strings.ContainsAny(string(s[0]), `+/`)) || (len(s) >= 8 && s[:8] == "go:build") {
-			q = fmt.Sprint("//" + s)
-		} else if len(ts) > 0 {
-			// Insert a space before the comment
-			q = fmt.Sprint("// " + s)
-		} else {
-			q = fmt.Sprint("//")
-		}
-		f.comments = append(f.comments, q)
-		f.lastComment = true
-		return nil
+	// Converted block comments are part of a block of line comments.
+	if (c.Block && f.opts.KeepBlockComments) || len(f.queued) > 0 {
+		f.flush()
+	}
+	// Newline before comments
+	if len(f.comments) == 0 {
+		f.newLine()
 	}
 
-	// Handle end-of blockcomments.
-	if strings.Contains(s, "/*") && !strings.HasSuffix(s, `\`) {
-		starts := strings.Index(s, "/*")
-		ends := strings.Index(s, "*/")
-		lineComment := strings.Index(s, "//")
-		if lineComment >= 0 {
-			if lineComment < starts {
-				goto exitcomm
-			}
-			if lineComment < ends && !f.insideBlock {
-				goto exitcomm
-			}
-			if ends > starts && ends < lineComment {
-				// If there is something left between the end and the line comment, keep it.
-				if len(strings.TrimSpace(s[ends:lineComment])) > 0 {
-					goto exitcomm
-				}
-			}
+	s := c.Text
+	if c.Block {
+		if f.opts.KeepBlockComments {
+			f.comments = append(f.comments, "/* "+strings.TrimSpace(s)+" */")
+			f.commentSrc = append(f.commentSrc, c.Position.Line)
+			f.lastComment = true
+			return
 		}
-		pre := s[:starts]
-		pre = strings.TrimSpace(pre)
+		// Convert single line /* comment */ to // Comment
+		s = " " + strings.TrimSpace(s)
+	}
+
+	// Preserve whitespace if the first character after the comment
+	// is a whitespace
+	ts := strings.TrimSpace(s)
+	var q string
+	if (ts != s && len(ts) > 0) || (len(s) > 0 && strings.ContainsAny(string(s[0]), `+/`)) || (len(s) >= 8 && s[:8] == "go:build") {
+		q = fmt.Sprint("//" + s)
+	} else if len(ts) > 0 && !f.opts.NoCommentSpace {
+		// Insert a space before the comment
+		q = fmt.Sprint("// " + s)
+	} else if len(ts) > 0 {
+		q = fmt.Sprint("//" + s)
+	} else {
+		q = fmt.Sprint("//")
+	}
+	f.comments = append(f.comments, q)
+	f.commentSrc = append(f.commentSrc, c.Position.Line)
+	f.lastComment = true
+	if !f.afterHeader && !c.Block {
+		f.header = append(f.header, "//"+c.Text)
+	}
+}
 
-		if len(pre) > 0 {
-			if strings.HasSuffix(s, `\`) {
-				goto exitcomm
+// addBlockComment will output a block comment.
+// Block comments are not indented.
+func (f *fstate) addBlockComment(b *BlockComment) {
+	f.flush()
+	lines := b.Lines
+	if len(lines) == 0 {
+		lines = []string{""}
+	}
+	lastStar := true // Last line started with a star.
+	for i, s := range lines {
+		last := i == len(lines)-1 && !b.Unterminated
+		switch {
+		case i == 0:
+			f.out.WriteString("/*")
+			if s = strings.TrimSpace(s); len(s) > 0 {
+				f.out.WriteString(" " + s)
 			}
-			// Add items before the comment section as a line.
-			if ends > starts && ends >= len(s)-2 {
-				comm := strings.TrimSpace(s[starts+2 : ends])
-				return f.addLine([]byte(pre + " //" + comm))
+			if last {
+				f.out.WriteString(" */")
 			}
-			err := f.addLine([]byte(pre))
-			if err != nil {
-				return err
+		case last:
+			ts := strings.TrimSpace(s)
+			if (len(ts) == 0 || ts[0] == '*') && lastStar {
+				s = ts + " "
 			}
+			f.out.WriteString(s + "*/")
+		default:
+			// Insert a space on lines that begin with '*'
+			lastStar = strings.HasPrefix(strings.TrimSpace(s), "*")
+			if lastStar {
+				s = " " + strings.TrimSpace(s)
+			}
+			f.out.WriteString(s)
 		}
-
-		f.flush()
-
-		// Convert single line /* comment */ to // Comment
-		if ends > starts && ends >= len(s)-2 {
-			return f.addLine([]byte("// " + strings.TrimSpace(s[starts+2:ends])))
-		}
-
-		// Comments inside multiline defines.
-		if strings.HasSuffix(s, `\`) {
-			f.indent()
-			s = strings.TrimSpace(strings.TrimSuffix(s, `\`)) + ` \`
-		}
-
-		// Otherwise output
-		fmt.Fprint(f.out, "/*")
-		s = strings.TrimSpace(s[starts+2:])
-		f.insideBlock = ends < 0
-		f.lastComment = true
-		f.lastStar = true
-		if len(s) == 0 {
-			f.out.WriteByte('\n')
-			return nil
+		if last && b.Continued {
+			f.out.WriteString(" \\")
 		}
-		f.out.WriteByte(' ')
-		f.out.WriteString(s + "\n")
-		return nil
+		f.endLine(b.Position.Line + i)
 	}
-exitcomm:
+	f.lastComment = true
+}
 
-	if len(s) == 0 {
-		f.flush()
+// addBlank adds an empty line from the source line.
+func (f *fstate) addBlank(line int) {
+	// Limit empty lines in a row
+	// cannot start with NL.
+	// Removed lines do not end the block, since they
+	// will not end it when the output is formatted again.
+	if f.emptyLines >= f.opts.maxBlankLines() || !f.anyContents {
+		return
+	}
+	f.flush()
+	if f.lastContinued {
+		f.indentation = 0
+		f.lastContinued = false
+		f.inDefine = false
+	}
+	f.emptyLines++
+	f.endLine(line)
+}
 
-		// No more than two empty lines in a row
-		// cannot start with NL
-		if f.lastEmpty || !f.anyContents {
-			return nil
+// addStatement adds a statement to the output.
+func (f *fstate) addStatement(st statement) {
+	if !f.afterHeader {
+		f.afterHeader = true
+		if f.arch == "" {
+			f.arch = constraintArch(f.header)
 		}
-		if f.lastContinued {
-			f.indentation = 0
-			f.lastContinued = false
+		f.header = nil
+	}
+	// Lines in the body of a #define are part of the macro
+	// and are not normalized.
+	define := f.inDefine
+	f.inDefine = (define || st.instruction == "#define") && st.continued
+	// Lines starting with a block comment are written as they are.
+	if st.commentFirst() {
+		f.flush()
+		f.out.WriteString("/* " + strings.TrimSpace(st.instruction[2:]))
+		f.endLine(st.line)
+		f.anyContents = true
+		f.emptyLines = 0
+		f.lastComment = true
+		return
+	}
+	if f.opts.NormalizeMnemonics && !define && !st.macro && !st.isLabel() && !st.isPreProcessor() {
+		if m := mnemonic(f.arch, st.instruction); m != "" {
+			st.instruction = m
 		}
-		f.lastEmpty = true
-		return f.out.WriteByte('\n')
+	}
+	if (f.opts.NormalizeOperands || f.opts.NormalizeNumbers) && !define && !st.macro && !st.isPreProcessor() {
+		st.params = f.opts.normalizeParams(st)
 	}
 
 	// Non-comment content is now added.
 	defer func() {
 		f.anyContents = true
-		f.lastEmpty = false
-		f.lastStar = false
+		f.emptyLines = 0
 		f.lastComment = false
 	}()
 
-	st := newStatement(s, f.defines)
-	if st == nil {
-		return nil
-	}
-	if def := st.define(); def != "" {
-		f.defines[def] = struct{}{}
-	}
-	if st.instruction == "package" {
-		if _, ok := f.defines["package"]; !ok {
-			return fmt.Errorf("package instruction found. Go files are not supported")
-		}
-	}
-
-	// Move anything that isn't a comment to the next line
-	if st.isLabel() && len(st.params) > 0 && !st.continued {
-		idx := strings.Index(s, ":")
-		st = newStatement(s[:idx+1], f.defines)
-		defer f.addLine([]byte(s[idx+1:]))
-	}
-
 	// Should this line be at level 0?
-	if st.level0() && !(st.continued && f.lastContinued) {
+	if st.level0() && !f.lastContinued {
 		if st.isTEXT() && len(f.queued) == 0 && len(f.comments) > 0 {
 			f.indentation = 0
 		}
@@ -261,21 +328,21 @@ exitcomm:
 		f.newLine()
 
 		f.indentation = 0
-		f.queued = append(f.queued, *st)
+		f.queued = append(f.queued, st)
 		f.flush()
 
 		if !st.isPreProcessor() && !st.isGlobal() {
 			f.indentation = 1
 		}
 		f.lastLabel = true
-		return nil
+		return
 	}
 
 	defer func() {
 		f.lastLabel = false
 	}()
-	f.queued = append(f.queued, *st)
-	if st.isTerminator() || (f.lastContinued && !st.continued) {
+	f.queued = append(f.queued, st)
+	if st.isTerminator(f.arch) || (f.lastContinued && !st.continued) {
 		// Terminators should always be at level 1
 		f.indentation = 1
 		f.flush()
@@ -285,36 +352,44 @@ exitcomm:
 		f.indentation = 1
 	}
 	f.lastContinued = st.continued
-	return nil
 }
 
 // indent the current line with current indentation.
 func (f *fstate) indent() {
-	for i := 0; i < f.indentation; i++ {
-		f.out.WriteByte('\t')
-	}
+	f.out.WriteString(f.opts.indent(f.indentation))
 }
 
 // flush any queued comments and commands
 func (f *fstate) flush() {
-	for _, line := range f.comments {
+	for i, line := range f.comments {
 		f.indent()
-		fmt.Fprintln(f.out, line)
+		f.out.WriteString(line)
+		f.endLine(f.commentSrc[i])
 	}
 	f.comments = nil
-	s := formatStatements(f.queued)
-	for _, line := range s {
+	f.commentSrc = nil
+	s := formatStatements(f.queued, &f.opts)
+	for i, line := range s {
 		f.indent()
-		fmt.Fprintln(f.out, line)
+		f.out.WriteString(line)
+		f.endLine(f.queued[i].line)
 	}
 	f.queued = nil
 }
 
+// endLine ends an output line created from the source line.
+func (f *fstate) endLine(src int) {
+	f.out.WriteByte('\n')
+	if f.trackLines {
+		f.srcLines = append(f.srcLines, src)
+	}
+}
+
 // Add a newline, unless last line was empty or a comment
 func (f *fstate) newLine() {
 	// Always newline before comment-only line.
-	if !f.lastEmpty && !f.lastComment && !f.lastLabel && f.anyContents {
-		f.out.WriteByte('\n')
+	if f.emptyLines == 0 && !f.lastComment && !f.lastLabel && !f.lastContinued && f.anyContents {
+		f.endLine(0)
 	}
 }
 
@@ -324,30 +399,27 @@ func newStatement(s string, defs map[string]struct{}) *statement {
 	s = strings.TrimSpace(s)
 	st := statement{}
 
-	// Fix where a comment start if any
-	// We need to make sure that the comment isn't embedded in a string literal
-	startcom := strings.Index(s, "//")
-	startstr := strings.Index(s, "\"")
-	for endstr := 0; startcom > startstr && startstr > endstr; {
-		// This does not check for any escaping (i.e. "\"")
-		endstr = startstr + 1 + strings.Index(s[startstr+1:], "\"")
-		startcom = endstr + strings.Index(s[endstr:], "//")
-		if startcom < endstr {
-			startcom = 0
+	// Split off the line comment, if any.
+	toks := lexer.Scan(s)
+	for i, t := range toks {
+		if t.Kind == lexer.LineComment && t.Offset > 0 {
+			st.comment = strings.TrimSpace(t.Text[2:])
+			s = strings.TrimSpace(s[:t.Offset])
+			toks = toks[:i]
+			break
 		}
-		startstr = endstr + 1 + strings.Index(s[endstr+1:], "\"")
 	}
-	if startcom > 0 {
-		st.comment = strings.TrimSpace(s[startcom+2:])
-		s = strings.TrimSpace(s[:startcom])
+	if len(toks) == 0 {
+		return nil
 	}
 
-	// Split into fields
-	fields := strings.Fields(s)
-	if len(fields) < 1 {
-		return nil
+	// The instruction is everything up to the first space.
+	for _, t := range toks {
+		if t.Kind == lexer.Space {
+			break
+		}
+		st.instruction += t.Text
 	}
-	st.instruction = fields[0]
 
 	// Handle defined macro calls
 	if len(defs) > 0 {
@@ -359,6 +431,13 @@ func newStatement(s string, defs map[string]struct{}) *statement {
 	if strings.HasPrefix(s, "/*") {
 		st.function = true
 	}
+	// Changing the spaces after a lone quote may turn it
+	// into a character literal, so the statement is kept.
+	for _, t := range toks {
+		if t.Kind == lexer.Char && t.Unterminated {
+			st.function = true
+		}
+	}
 	// We may not have it defined as a macro, if defined in an external
 	// .h file, so we try to detect the remaining ones.
 	if strings.ContainsAny(st.instruction, "(_") {
@@ -369,9 +448,10 @@ func newStatement(s string, defs map[string]struct{}) *statement {
 	}
 	if st.function {
 		st.instruction = s
+		st.macro = true
 	}
 
-	if st.instruction == "\\" && len(st.comment) > 0 {
+	if s == "\\" && len(st.comment) > 0 {
 		st.instruction = fmt.Sprintf("\\ // %s", st.comment)
 		st.comment = ""
 		st.function = true
@@ -380,16 +460,23 @@ func newStatement(s string, defs map[string]struct{}) *statement {
 	}
 
 	s = strings.TrimPrefix(s, st.instruction)
-	st.instruction = strings.Replace(st.instruction, "\t", " ", -1)
+	st.instruction = replaceTabs(st.instruction)
 	s = strings.TrimSpace(s)
 
 	st.setParams(s)
 
 	// Remove trailing ;
-	if len(st.params) > 0 {
-		st.params[len(st.params)-1] = strings.TrimSuffix(st.params[len(st.params)-1], ";")
-	} else {
-		st.instruction = strings.TrimSuffix(st.instruction, ";")
+	// A statement consisting only of semicolons is kept.
+	for len(st.params) > 0 {
+		p := strings.TrimRightFunc(st.params[len(st.params)-1], isTrailing)
+		if len(p) > 0 {
+			st.params[len(st.params)-1] = p
+			break
+		}
+		st.params = st.params[:len(st.params)-1]
+	}
+	if len(st.params) == 0 && strings.TrimFunc(st.instruction, isTrailing) != "" {
+		st.instruction = strings.TrimRightFunc(st.instruction, isTrailing)
 	}
 
 	// Register line continuations.
@@ -406,7 +493,7 @@ func newStatement(s string, defs map[string]struct{}) *statement {
 			st.continued = true
 		}
 	}
-	if strings.HasSuffix(st.instruction, `\`) && !st.contComment {
+	if strings.HasSuffix(st.instruction, `\`) && len(st.params) == 0 && !st.continued && !st.contComment {
 		i := strings.TrimSuffix(st.instruction, `\`)
 		st.instruction = strings.TrimSpace(i)
 		st.continued = true
@@ -419,69 +506,63 @@ func newStatement(s string, defs map[string]struct{}) *statement {
 	return &st
 }
 
+// isTrailing returns true for characters removed from the end of statements.
+func isTrailing(r rune) bool {
+	return r == ';' || unicode.IsSpace(r)
+}
+
+// replaceTabs replaces tabs outside string and character literals with spaces.
+func replaceTabs(s string) string {
+	if !strings.Contains(s, "\t") {
+		return s
+	}
+	var b strings.Builder
+	for _, t := range lexer.Scan(s) {
+		if t.Kind != lexer.String && t.Kind != lexer.Char {
+			t.Text = strings.Replace(t.Text, "\t", " ", -1)
+		}
+		b.WriteString(t.Text)
+	}
+	return b.String()
+}
+
 // setParams will add the string given as parameters.
 // Inline comments are retained.
 // There will be a space after ",", unless inside a comment.
-// A tab is replaced by a space for consistent indentation.
+// A tab outside string and character literals is replaced by a space
+// for consistent indentation.
 func (st *statement) setParams(s string) {
 	st.params = make([]string, 0)
-	runes := []rune(s)
-	last := '\n'
-	inComment := false
-	inStringLiteral := false
-	inCharLiteral := false
-	out := make([]rune, 0, len(runes))
-	for _, r := range runes {
-		switch r {
-		case '"':
-			if last != '\\' && inStringLiteral {
-				inStringLiteral = false
-			} else if last != '\\' && !inStringLiteral {
-				inStringLiteral = true
-			}
-		case '\'':
-			if last != '\\' && inCharLiteral {
-				inCharLiteral = false
-			} else if last != '\\' && !inCharLiteral {
-				inCharLiteral = true
-			}
-		case ',':
-			if inComment || inStringLiteral || inCharLiteral {
-				break
-			}
-			c := strings.TrimSpace(string(out))
+	var out strings.Builder
+	afterSemicolon := false
+	for _, t := range lexer.Scan(s) {
+		text := t.Text
+		switch t.Kind {
+		case lexer.Comma:
+			c := strings.TrimSpace(out.String())
 			if len(c) > 0 {
 				st.params = append(st.params, c)
 			}
-			out = out[0:0]
+			out.Reset()
 			continue
-		case '/':
-			if last == '*' && inComment {
-				inComment = false
-			}
-		case '*':
-			if last == '/' {
-				inComment = true
-			}
-		case '\t':
-			if !st.isPreProcessor() {
-				r = ' '
-			}
-		case ';':
-			if inComment || inStringLiteral || inCharLiteral {
-				break
-			}
-			out = []rune(strings.TrimSpace(string(out)) + "; ")
-			last = r
+		case lexer.Semicolon:
+			c := strings.TrimSpace(out.String())
+			out.Reset()
+			out.WriteString(c + "; ")
+			afterSemicolon = true
 			continue
+		case lexer.Space:
+			if afterSemicolon {
+				continue
+			}
 		}
-		if last == ';' && unicode.IsSpace(r) {
-			continue
+		afterSemicolon = false
+		if t.Kind != lexer.String && t.Kind != lexer.Char && !st.isPreProcessor() {
+			text = strings.Replace(text, "\t", " ", -1)
 		}
-		last = r
-		out = append(out, r)
+		out.WriteString(text)
 	}
-	c := strings.TrimSpace(string(out))
+	c := strings.TrimSpace(out.String())
 	if len(c) > 0 {
 		st.params = append(st.params, c)
 	}
@@ -523,9 +604,9 @@ func (st statement) isTEXT() bool {
 
 // We attempt to identify "terminators", after which
 // indentation is likely to be level 0.
-func (st statement) isTerminator() bool {
-	up := strings.ToUpper(st.instruction)
-	return up == "RET" || up == "JMP"
+// The instructions depend on the architecture, see archTerminators.
+func (st statement) isTerminator(arch string) bool {
+	return isTerminator(arch, strings.ToUpper(st.instruction), st.params)
 }
 
 // Detects commands based on case.
@@ -548,6 +629,16 @@ func (st statement) willContinue() bool {
 	return strings.HasSuffix(st.params[len(st.params)-1], `\`)
 }
 
+// commentFirst returns true if the statement starts with
+// a block comment, which is followed by more content.
+func (st statement) commentFirst() bool {
+	if !st.macro || st.continued || len(st.comment) > 0 || !strings.HasPrefix(st.instruction, "/*") {
+		return false
+	}
+	t := lexer.Scan(st.instruction)[0]
+	return !t.Unterminated && t.End() < len(st.instruction)
+}
+
 // define returns the macro defined in this line.
 // if none is defined "" is returned.
 func (st statement) define() string {
@@ -570,7 +661,7 @@ func (st *statement) cleanParams() {
 // formatStatements will format a slice of statements and return each line
 // as a separate string.
 // Comments and line-continuation (\) are aligned with spaces.
-func formatStatements(s []statement) []string {
+func formatStatements(s []statement, opts *Options) []string {
 	res := make([]string, len(s))
 	maxParam := 0 // Length of longest parameter
 	maxInstr := 0 // Length of longest instruction WITH parameters.
@@ -618,17 +709,23 @@ func formatStatements(s []statement) []string {
 		}
 		p := strings.Join(x.params, ", ")
 		if len(x.params) > 0 || len(x.comment) > 0 {
-			for len(r) < maxInstr {
+			for len([]rune(r)) < maxInstr {
 				r += " "
 			}
 		}
 		r = r + p
 		if len(x.comment) > 0 && !x.continued {
 			it := maxParam - len([]rune(r))
+			if opts.NoCommentAlign {
+				it = 1
+			}
+			if c := opts.CommentColumn - len([]rune(r)); c > it {
+				it = c
+			}
 			for i := 0; i < it; i++ {
 				r = r + " "
 			}
-			r += fmt.Sprintf("// %s", x.comment)
+			r += opts.comment(x)
 		}
 
 		if x.continued {
@@ -2,13 +2,15 @@ package asmfmt
 
 import (
 	"bytes"
+	"errors"
 	"flag"
+	"io"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 var update = flag.Bool("update", false, "update .golden files")
@@ -26,7 +28,8 @@ func runTest(t *testing.T, in, out string) {
 	}
 	defer f.Close()
 
-	got, err := Format(f)
+	// The architecture is inferred from the file name.
+	got, err := FormatWithOptions(f, Options{Filename: in})
 	if err != nil {
 		t.Error(in, "-", err)
 		return
@@ -54,10 +57,7 @@ func runTest(t *testing.T, in, out string) {
 		}
 
 		t.Errorf("(gofmt %s) != %s (see %s.asmfmt)", in, out, in)
-		d, err := diff(expected, got)
-		if err == nil {
-			t.Errorf("%s", d)
-		}
+		t.Errorf("%s", UnifiedDiff(out, expected, got, 3))
 		if err := ioutil.WriteFile(in+".asmfmt", got, 0666); err != nil {
 			t.Error(err)
 		}
@@ -91,32 +91,164 @@ func TestRewrite(t *testing.T) {
 	}
 }
 
-func diff(b1, b2 []byte) (data []byte, err error) {
-	f1, err := ioutil.TempFile("", "asmfmt")
+// TestFprint checks that parsing and printing the testdata
+// files gives the same result as Format.
+func TestFprint(t *testing.T) {
+	match, err := filepath.Glob("testdata/*.golden")
 	if err != nil {
-		return
+		t.Fatal(err)
+	}
+	for _, golden := range match {
+		expected, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected = []byte(strings.Replace(string(expected), "\r\n", "\n", -1))
+		for _, in := range []string{golden, strings.TrimSuffix(golden, ".golden") + ".in"} {
+			src, err := ioutil.ReadFile(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, err := Parse(bytes.NewBuffer(src))
+			if err != nil {
+				t.Error(in, "-", err)
+				continue
+			}
+			var got bytes.Buffer
+			if err := FprintWithOptions(&got, f, Options{Filename: in}); err != nil {
+				t.Error(in, "-", err)
+				continue
+			}
+			if !bytes.Equal(got.Bytes(), expected) {
+				t.Errorf("Fprint(Parse(%s)) != %s", in, golden)
+			}
+		}
+	}
+}
+
+// chunkWriter records each write.
+type chunkWriter struct {
+	chunks []string
+	err    error
+
+	// If in is set, eof records for each write
+	// whether all of in had been read.
+	in  *eofReader
+	eof []bool
+}
+
+func (w *chunkWriter) Write(b []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	w.chunks = append(w.chunks, string(b))
+	if w.in != nil {
+		w.eof = append(w.eof, w.in.eof)
+	}
+	return len(b), nil
+}
+
+// eofReader records when the end of the input is read.
+type eofReader struct {
+	r   io.Reader
+	eof bool
+}
+
+func (r *eofReader) Read(b []byte) (int, error) {
+	n, err := r.r.Read(b)
+	if err == io.EOF {
+		r.eof = true
 	}
-	defer os.Remove(f1.Name())
-	defer f1.Close()
+	return n, err
+}
 
-	f2, err := ioutil.TempFile("", "asmfmt")
+func TestFormatTo(t *testing.T) {
+	match, err := filepath.Glob("testdata/*.in")
 	if err != nil {
-		return
+		t.Fatal(err)
+	}
+	for _, in := range match {
+		src, err := ioutil.ReadFile(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts := Options{Filename: in}
+		want, err := FormatWithOptions(bytes.NewReader(src), opts)
+		if err != nil {
+			t.Error(in, "-", err)
+			continue
+		}
+		var w chunkWriter
+		if err := FormatToWithOptions(&w, bytes.NewReader(src), opts); err != nil {
+			t.Error(in, "-", err)
+			continue
+		}
+		if got := strings.Join(w.chunks, ""); got != string(want) {
+			t.Errorf("%s: FormatTo differs from Format:\n%s", in, UnifiedDiff(in, want, []byte(got), 3))
+		}
 	}
-	defer os.Remove(f2.Name())
-	defer f2.Close()
 
-	f1.Write(b1)
-	f2.Write(b2)
+	// Each block is written when it is complete,
+	// before the rest of the input is read.
+	input := "TEXT ·f(SB),$0\nMOVQ a+0(FP), AX\nRET\n\nTEXT ·g(SB),$0\nRET\n"
+	want, err := Format(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := &eofReader{r: iotest.OneByteReader(strings.NewReader(input))}
+	w := chunkWriter{in: in}
+	if err := FormatTo(&w, in); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(w.chunks, ""); got != string(want) {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+	if len(w.chunks) < 3 {
+		t.Errorf("got %d writes: %q", len(w.chunks), w.chunks)
+	}
+	if len(w.eof) == 0 || w.eof[0] {
+		t.Errorf("first write after reading all input: %q", w.chunks)
+	}
 
-	data, err = exec.Command("diff", "-u", f1.Name(), f2.Name()).CombinedOutput()
-	if len(data) > 0 {
-		// diff exits with a non-zero status when the files don't match.
-		// Ignore that failure as long as we get output.
-		err = nil
+	// Write errors are returned.
+	w = chunkWriter{err: errors.New("write failed")}
+	if err := FormatTo(&w, strings.NewReader(input)); err != w.err {
+		t.Errorf("got error %v, want %v", err, w.err)
 	}
-	return
+}
 
+// Modified trees must be formatted.
+func TestFprintModified(t *testing.T) {
+	input := `TEXT ·add(SB), NOSPLIT, $0-24
+	MOVQ a+0(FP), AX
+	MOVQ AX, ret+16(FP) // store
+	RET
+`
+	f, err := Parse(bytes.NewBufferString(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn := f.Funcs()[0]
+	fn.Body = append([]Node{
+		&Comment{Text: "Load"},
+		&Stmt{Op: "MOVQ", Args: []string{"b+8(FP)", "BX"}},
+		&Stmt{Op: "ADDQ", Args: []string{"BX", "AX"}, Comment: "add"},
+	}, fn.Body...)
+	var got bytes.Buffer
+	if err := Fprint(&got, f); err != nil {
+		t.Fatal(err)
+	}
+	want := `TEXT ·add(SB), NOSPLIT, $0-24
+	// Load
+	MOVQ b+8(FP), BX
+	ADDQ BX, AX         // add
+	MOVQ a+0(FP), AX
+	MOVQ AX, ret+16(FP) // store
+	RET
+`
+	if got.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got.String(), want)
+	}
 }
 
 // Go files must fail.
@@ -142,3 +274,196 @@ func TestZeroByteFile(t *testing.T) {
 		return
 	}
 }
+
+func TestFormatWithOptions(t *testing.T) {
+	input := `TEXT ·f(SB), $0
+	MOVQ a+0(FP), AX /* load */
+	ADDQ $1, AX // inc
+//comment
+	/* block */
+
+
+
+	RET
+`
+	tests := []struct {
+		name string
+		opts Options
+		want string
+	}{
+		{
+			name: "default",
+			want: "TEXT ·f(SB), $0\n\tMOVQ a+0(FP), AX // load\n\tADDQ $1, AX      // inc\n\n\t// comment\n\t// block\n\n\tRET\n",
+		},
+		{
+			name: "indent",
+			opts: Options{Indent: "    "},
+			want: "TEXT ·f(SB), $0\n    MOVQ a+0(FP), AX // load\n    ADDQ $1, AX      // inc\n\n    // comment\n    // block\n\n    RET\n",
+		},
+		{
+			name: "keep-block",
+			opts: Options{KeepBlockComments: true},
+			want: "TEXT ·f(SB), $0\n\tMOVQ a+0(FP), AX /* load */\n\tADDQ $1, AX      // inc\n\n\t// comment\n\t/* block */\n\n\tRET\n",
+		},
+		{
+			name: "blank-lines",
+			opts: Options{MaxBlankLines: 2},
+			want: "TEXT ·f(SB), $0\n\tMOVQ a+0(FP), AX // load\n\tADDQ $1, AX      // inc\n\n\t// comment\n\t// block\n\n\n\tRET\n",
+		},
+		{
+			name: "no-blank-lines",
+			opts: Options{MaxBlankLines: -1},
+			want: "TEXT ·f(SB), $0\n\tMOVQ a+0(FP), AX // load\n\tADDQ $1, AX      // inc\n\n\t// comment\n\t// block\n\tRET\n",
+		},
+		{
+			name: "no-comment-space",
+			opts: Options{NoCommentSpace: true},
+			want: "TEXT ·f(SB), $0\n\tMOVQ a+0(FP), AX // load\n\tADDQ $1, AX      // inc\n\n\t//comment\n\t// block\n\n\tRET\n",
+		},
+		{
+			name: "no-comment-align",
+			opts: Options{NoCommentAlign: true},
+			want: "TEXT ·f(SB), $0\n\tMOVQ a+0(FP), AX // load\n\tADDQ $1, AX // inc\n\n\t// comment\n\t// block\n\n\tRET\n",
+		},
+		{
+			name: "comment-column",
+			opts: Options{CommentColumn: 24},
+			want: "TEXT ·f(SB), $0\n\tMOVQ a+0(FP), AX        // load\n\tADDQ $1, AX             // inc\n\n\t// comment\n\t// block\n\n\tRET\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := FormatWithOptions(strings.NewReader(input), test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != test.want {
+				t.Errorf("got:\n%q\nwant:\n%q", got, test.want)
+			}
+		})
+	}
+}
+
+// Errors must be returned as a list with positions.
+func TestErrorList(t *testing.T) {
+	input := "TEXT ·f(SB), $0\n\tMOVQ AX, \x00BX\n\tRET\n  package main\n\x00\n"
+	_, err := FormatWithOptions(strings.NewReader(input), Options{Filename: "f.s"})
+	list, ok := err.(ErrorList)
+	if !ok {
+		t.Fatalf("expected ErrorList, got %T: %v", err, err)
+	}
+	want := []string{
+		"f.s:2:11: zero (0) byte in input. file is unlikely an assembler file",
+		"f.s:4:3: package instruction found. Go files are not supported",
+		"f.s:5:1: zero (0) byte in input. file is unlikely an assembler file",
+	}
+	kinds := []ErrorKind{KindZeroByte, KindGoFile, KindZeroByte}
+	if len(list) != len(want) {
+		t.Fatalf("got %d errors, want %d: %v", len(list), len(want), list)
+	}
+	for i, e := range list {
+		if e.Error() != want[i] {
+			t.Errorf("got %q, want %q", e.Error(), want[i])
+		}
+		if e.Kind != kinds[i] {
+			t.Errorf("got kind %v, want %v", e.Kind, kinds[i])
+		}
+	}
+	if _, err := Parse(strings.NewReader(input)); err == nil {
+		t.Error("Parse did not return an error")
+	}
+}
+
+// A panic while formatting must be returned as an error.
+func TestInternalError(t *testing.T) {
+	var buf bytes.Buffer
+	err := FprintWithOptions(&buf, &File{Nodes: []Node{&Func{}}}, Options{Filename: "f.s"})
+	if _, ok := err.(*internalError); !ok {
+		t.Fatalf("expected internal error, got %T: %v", err, err)
+	}
+	if !strings.HasPrefix(err.Error(), "f.s: internal error: ") {
+		t.Errorf("got %q", err)
+	}
+	if buf.Len() > 0 {
+		t.Errorf("output written: %q", buf.String())
+	}
+}
+
+func TestUnterminated(t *testing.T) {
+	tests := []struct {
+		input string
+		kind  ErrorKind
+		pos   Pos
+	}{
+		{input: "TEXT ·f(SB), $0\n/* comment\n\tRET\n", kind: KindUnterminatedComment, pos: Pos{2, 1}},
+		{input: "DATA x<>+0(SB)/8, $\"abc\n", kind: KindUnterminatedString, pos: Pos{1, 20}},
+		{input: "DATA x<>+0(SB)/8, $\"a\\\"bc // \n", kind: KindUnterminatedString, pos: Pos{1, 20}},
+		{input: "\tMOVQ $'a, AX // it's\n", kind: KindUnterminatedChar, pos: Pos{1, 8}},
+		{input: "'loop: RET\n", kind: KindUnterminatedChar, pos: Pos{1, 1}},
+		{input: "\tMOVQ $'\\'', AX // it's\n"},
+		{input: "\tMOVQ $'/', AX /* it's */\n"},
+		{input: "#error don't\n"},
+	}
+	for _, test := range tests {
+		_, err := Format(strings.NewReader(test.input))
+		if test.kind == 0 {
+			if err != nil {
+				t.Errorf("%q: unexpected error: %v", test.input, err)
+			}
+			continue
+		}
+		list, ok := err.(ErrorList)
+		if !ok || len(list) != 1 {
+			t.Errorf("%q: expected one error, got %v", test.input, err)
+			continue
+		}
+		if list[0].Kind != test.kind || list[0].Pos != test.pos {
+			t.Errorf("%q: got %v (%v), want %v at %v", test.input, list[0], list[0].Kind, test.kind, test.pos)
+		}
+
+		// Warnings must not prevent formatting.
+		var warnings []*Error
+		opts := Options{Warn: func(err *Error) { warnings = append(warnings, err) }}
+		if _, err := FormatWithOptions(strings.NewReader(test.input), opts); err != nil {
+			t.Errorf("%q: unexpected error: %v", test.input, err)
+		}
+		if len(warnings) != 1 || warnings[0].Kind != test.kind {
+			t.Errorf("%q: unexpected warnings: %v", test.input, warnings)
+		}
+	}
+}
+
+func TestNormalizeMnemonics(t *testing.T) {
+	tests := []struct {
+		arch, input, want string
+	}{
+		{
+			arch:  "amd64",
+			input: "#define load(r) movq (r), r\n#define zero xorq AX, AX\n#define clear(r) \\\n\txorq r, r \\\n\tnegq r\n\ntext ·f(SB),$0\nmovq AX,BX\nMovQ BX,CX\nload(AX)\nzero\nloop:\nvpxor Y0,Y0,Y0\nmacro AX\nret\n\nglobl tbl<>(SB),$8\n",
+			want:  "#define load(r) movq (r), r\n#define zero xorq AX, AX\n#define clear(r) \\\n\txorq r, r \\\n\tnegq r\n\nTEXT ·f(SB), $0\n\tMOVQ AX, BX\n\tMOVQ BX, CX\n\tload(AX)\n\tzero\n\nloop:\n\tVPXOR Y0, Y0, Y0\n\tmacro AX\n\tRET\n\nGLOBL tbl<>(SB), $8\n",
+		},
+		{
+			arch:  "arm64",
+			input: "TEXT ·f(SB),$0\nmovd.p R1,8(R2)\nb ·g(SB)\n",
+			want:  "TEXT ·f(SB), $0\n\tMOVD.P R1, 8(R2)\n\tB      ·g(SB)\n",
+		},
+		{
+			arch:  "wasm",
+			input: "TEXT ·f(SB),$0\ni32const $1\ncall ·g(SB)\nreturn\n",
+			want:  "TEXT ·f(SB), $0\n\tI32Const $1\n\tcall     ·g(SB)\n\tReturn\n",
+		},
+		{
+			input: "TEXT ·f(SB),$0\nmovq AX,BX\nmovd.p R1,8(R2)\ni32const $1\n",
+			want:  "TEXT ·f(SB), $0\n\tMOVQ     AX, BX\n\tMOVD.P   R1, 8(R2)\n\ti32const $1\n",
+		},
+	}
+	for _, test := range tests {
+		got, err := FormatWithOptions(strings.NewReader(test.input), Options{Arch: test.arch, NormalizeMnemonics: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != test.want {
+			t.Errorf("%s: got:\n%q\nwant:\n%q", test.arch, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package asmfmt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Pos is a position in the source.
+// Line and Column are 1-based, Column is counted in bytes.
+type Pos struct {
+	Line   int
+	Column int
+}
+
+// IsValid returns true if the position has a line number.
+func (p Pos) IsValid() bool {
+	return p.Line > 0
+}
+
+// String returns the position as "line:column".
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Node is an element of a parsed assembler file.
+// It is one of *Func, *Stmt, *Comment, *BlockComment or *Blank.
+type Node interface {
+	// Pos returns the position of the first character of the node.
+	Pos() Pos
+}
+
+// File is a parsed assembler file.
+type File struct {
+	Nodes []Node
+}
+
+// Funcs returns all functions in the file.
+func (f *File) Funcs() []*Func {
+	var res []*Func
+	for _, n := range f.Nodes {
+		if fn, ok := n.(*Func); ok {
+			res = append(res, fn)
+		}
+	}
+	return res
+}
+
+// Walk calls fn for every node in the file in source order.
+// For functions, the function itself is visited first,
+// followed by the TEXT directive and the body.
+func (f *File) Walk(fn func(Node)) {
+	walk(f.Nodes, fn)
+}
+
+func walk(nodes []Node, fn func(Node)) {
+	for _, n := range nodes {
+		fn(n)
+		if f, ok := n.(*Func); ok {
+			fn(f.Text)
+			walk(f.Body, fn)
+		}
+	}
+}
+
+// Func is a function: a TEXT directive and everything following it,
+// up to the next TEXT, DATA or GLOBL directive.
+// Comments and blank lines directly preceding the next directive
+// are not part of the body.
+type Func struct {
+	Text *Stmt
+	Body []Node
+}
+
+// Pos returns the position of the TEXT directive.
+func (f *Func) Pos() Pos { return f.Text.Pos() }
+
+// Name returns the symbol name of the function, for instance "·Add(SB)".
+func (f *Func) Name() string {
+	if len(f.Text.Args) == 0 {
+		return ""
+	}
+	return f.Text.Args[0]
+}
+
+// StmtKind is the type of a statement.
+type StmtKind int
+
+const (
+	// Instruction is a machine instruction, for instance "MOVQ AX, BX".
+	Instruction StmtKind = iota
+	// Label is a jump target, for instance "loop:".
+	Label
+	// Directive is a TEXT, DATA, GLOBL, FUNCDATA or PCDATA directive.
+	Directive
+	// Preprocessor is a line starting with '#', for instance "#define".
+	Preprocessor
+	// Macro is a macro invocation or another statement
+	// that is not split into operands.
+	Macro
+)
+
+// String returns the name of the kind.
+func (k StmtKind) String() string {
+	switch k {
+	case Instruction:
+		return "instruction"
+	case Label:
+		return "label"
+	case Directive:
+		return "directive"
+	case Preprocessor:
+		return "preprocessor"
+	case Macro:
+		return "macro"
+	}
+	return fmt.Sprintf("StmtKind(%d)", int(k))
+}
+
+// Stmt is a single statement.
+type Stmt struct {
+	Position Pos
+
+	// Op is the instruction, directive or preprocessor keyword.
+	// For labels it is the label name including the colon.
+	// For macros it is the complete statement.
+	Op string
+
+	// Args contains the operands, without separating commas.
+	Args []string
+
+	// Comment is the trailing comment without slashes.
+	Comment string
+
+	// CommentBlock is set if the trailing comment was written
+	// as a block comment (/* comment */).
+	CommentBlock bool
+
+	// Macro is set if Op is a macro invocation or another statement
+	// that is kept as it is.
+	Macro bool
+
+	// Continued is set if the statement is continued on the next line
+	// with a backslash, as done in multiline macros.
+	Continued bool
+}
+
+// Pos returns the position of the statement.
+func (s *Stmt) Pos() Pos { return s.Position }
+
+// Kind returns the type of the statement.
+func (s *Stmt) Kind() StmtKind {
+	st := statement{instruction: s.Op}
+	switch {
+	case st.isLabel():
+		return Label
+	case st.isPreProcessor():
+		return Preprocessor
+	case s.Macro:
+		return Macro
+	case st.isTEXT():
+		return Directive
+	}
+	return Instruction
+}
+
+// Comment is a line comment on a separate line.
+type Comment struct {
+	Position Pos
+
+	// Text of the comment without the leading slashes.
+	// For block comments it is the trimmed text between "/*" and "*/".
+	Text string
+
+	// Block is set if the comment was written as a single line block
+	// comment (/* text */).
+	Block bool
+}
+
+// Pos returns the position of the comment.
+func (c *Comment) Pos() Pos { return c.Position }
+
+// BlockComment is a block comment (/* ... */).
+type BlockComment struct {
+	Position Pos
+
+	// Lines contains the text between "/*" and "*/", one line per entry.
+	Lines []string
+
+	// End is the position of the closing "*/".
+	End Pos
+
+	// Continued is set if the comment is followed by a backslash,
+	// as done in multiline macros.
+	Continued bool
+
+	// Unterminated is set if the end of the file was reached
+	// before the comment was closed.
+	Unterminated bool
+}
+
+// Pos returns the position of the opening "/*".
+func (c *BlockComment) Pos() Pos { return c.Position }
+
+// Text returns the text of the comment with lines separated by newlines.
+func (c *BlockComment) Text() string {
+	return strings.Join(c.Lines, "\n")
+}
+
+// Blank is an empty line.
+type Blank struct {
+	Position Pos
+}
+
+// Pos returns the position of the empty line.
+func (b *Blank) Pos() Pos { return b.Position }
@@ -0,0 +1,424 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/asmfmt"
+)
+
+// configNames contains the names of configuration files
+// in order of precedence.
+var configNames = []string{".asmfmt.toml", ".asmfmt.json"}
+
+// config contains the settings of a configuration file.
+// Settings that are not present in the file are nil.
+type config struct {
+	Indent             *string  `json:"indent,omitempty"`
+	KeepBlockComments  *bool    `json:"keep_block_comments,omitempty"`
+	MaxBlankLines      *int     `json:"max_blank_lines,omitempty"`
+	NoCommentSpace     *bool    `json:"no_comment_space,omitempty"`
+	NoCommentAlign     *bool    `json:"no_comment_align,omitempty"`
+	CommentColumn      *int     `json:"comment_column,omitempty"`
+	NormalizeMnemonics *bool    `json:"normalize_mnemonics,omitempty"`
+	NormalizeOperands  *bool    `json:"normalize_operands,omitempty"`
+	NormalizeNumbers   *bool    `json:"normalize_numbers,omitempty"`
+	UpperHex           *bool    `json:"upper_hex,omitempty"`
+	PadData            *bool    `json:"pad_data,omitempty"`
+	Exclude            []string `json:"exclude,omitempty"`
+
+	path string // File the configuration was read from.
+}
+
+// apply the settings to the options.
+func (c *config) apply(opts *asmfmt.Options) {
+	if c == nil {
+		return
+	}
+	if c.Indent != nil {
+		opts.Indent = *c.Indent
+	}
+	if c.KeepBlockComments != nil {
+		opts.KeepBlockComments = *c.KeepBlockComments
+	}
+	if c.MaxBlankLines != nil {
+		opts.MaxBlankLines = blankLines(*c.MaxBlankLines)
+	}
+	if c.NoCommentSpace != nil {
+		opts.NoCommentSpace = *c.NoCommentSpace
+	}
+	if c.NoCommentAlign != nil {
+		opts.NoCommentAlign = *c.NoCommentAlign
+	}
+	if c.CommentColumn != nil {
+		opts.CommentColumn = *c.CommentColumn
+	}
+	if c.NormalizeMnemonics != nil {
+		opts.NormalizeMnemonics = *c.NormalizeMnemonics
+	}
+	if c.NormalizeOperands != nil {
+		opts.NormalizeOperands = *c.NormalizeOperands
+	}
+	if c.NormalizeNumbers != nil {
+		opts.NormalizeNumbers = *c.NormalizeNumbers
+	}
+	if c.UpperHex != nil {
+		opts.UpperHex = *c.UpperHex
+	}
+	if c.PadData != nil {
+		opts.PadData = *c.PadData
+	}
+}
+
+// excluded returns true if the path is excluded by the configuration.
+// Patterns are matched against the path relative to the directory
+// of the configuration file. Patterns without a slash are also
+// matched against the base name.
+func (c *config) excluded(path string) bool {
+	if c == nil || len(c.Exclude) == 0 {
+		return false
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(filepath.Dir(c.path), abs)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	for _, pattern := range c.Exclude {
+		pattern = strings.TrimSuffix(pattern, "/")
+		if ok, _ := filepath.Match(pattern, rel); ok {
+			return true
+		}
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := filepath.Match(pattern, filepath.Base(abs)); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// loadConfig reads the configuration file.
+func loadConfig(path string) (*config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(path, ".toml") {
+		values, err := parseTOML(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		data, err = json.Marshal(values)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+	}
+	var c config
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&c); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	c.path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// configs caches configurations by directory.
+var configs = struct {
+	sync.Mutex
+	dirs map[string]*config
+}{dirs: make(map[string]*config)}
+
+// findConfig returns the configuration for files in dir.
+// If the -config flag is given, that file is used.
+// Otherwise dir and its parents are searched for a configuration file.
+// If none is found, nil is returned.
+func findConfig(dir string) (*config, error) {
+	configs.Lock()
+	defer configs.Unlock()
+	if *configFile != "" {
+		return cachedConfig("", func() (*config, error) {
+			return loadConfig(*configFile)
+		})
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	return searchConfig(dir)
+}
+
+// searchConfig searches dir and its parents for a configuration file.
+// configs must be locked.
+func searchConfig(dir string) (*config, error) {
+	return cachedConfig(dir, func() (*config, error) {
+		for _, name := range configNames {
+			path := filepath.Join(dir, name)
+			if _, err := os.Stat(path); err == nil {
+				return loadConfig(path)
+			}
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, nil
+		}
+		return searchConfig(parent)
+	})
+}
+
+func cachedConfig(dir string, load func() (*config, error)) (*config, error) {
+	if c, ok := configs.dirs[dir]; ok {
+		return c, nil
+	}
+	c, err := load()
+	if err != nil {
+		return nil, err
+	}
+	configs.dirs[dir] = c
+	return c, nil
+}
+
+// configDir returns the directory used for finding the configuration
+// of the file.
+func configDir(filename string, stdin bool) string {
+	if stdin {
+		return "."
+	}
+	return filepath.Dir(filename)
+}
+
+// writeConfig writes the effective settings for path to w.
+func writeConfig(w io.Writer, path string) error {
+	dir := path
+	if fi, err := os.Stat(path); err != nil {
+		return err
+	} else if !fi.IsDir() {
+		dir = filepath.Dir(path)
+	}
+	cfg, err := findConfig(dir)
+	if err != nil {
+		return err
+	}
+	opts := options(cfg)
+	if cfg != nil {
+		fmt.Fprintf(w, "# %s: %s\n", path, cfg.path)
+	} else {
+		fmt.Fprintf(w, "# %s: no configuration file\n", path)
+	}
+	indent := opts.Indent
+	if indent == "" {
+		indent = "\t"
+	}
+	fmt.Fprintf(w, "indent = %s\n", strconv.Quote(indent))
+	fmt.Fprintf(w, "keep_block_comments = %t\n", opts.KeepBlockComments)
+	fmt.Fprintf(w, "max_blank_lines = %d\n", maxBlankLinesSetting(opts.MaxBlankLines))
+	fmt.Fprintf(w, "no_comment_space = %t\n", opts.NoCommentSpace)
+	fmt.Fprintf(w, "no_comment_align = %t\n", opts.NoCommentAlign)
+	fmt.Fprintf(w, "comment_column = %d\n", opts.CommentColumn)
+	fmt.Fprintf(w, "normalize_mnemonics = %t\n", opts.NormalizeMnemonics)
+	fmt.Fprintf(w, "normalize_operands = %t\n", opts.NormalizeOperands)
+	fmt.Fprintf(w, "normalize_numbers = %t\n", opts.NormalizeNumbers)
+	fmt.Fprintf(w, "upper_hex = %t\n", opts.UpperHex)
+	fmt.Fprintf(w, "pad_data = %t\n", opts.PadData)
+	var exclude []string
+	if cfg != nil {
+		for _, e := range cfg.Exclude {
+			exclude = append(exclude, strconv.Quote(e))
+		}
+	}
+	fmt.Fprintf(w, "exclude = [%s]\n", strings.Join(exclude, ", "))
+	return nil
+}
+
+// blankLines converts the number of empty lines given as a setting
+// to asmfmt.Options.MaxBlankLines.
+func blankLines(n int) int {
+	if n <= 0 {
+		return -1
+	}
+	return n
+}
+
+// maxBlankLinesSetting converts asmfmt.Options.MaxBlankLines
+// to the number of empty lines given as a setting.
+func maxBlankLinesSetting(n int) int {
+	switch {
+	case n == 0:
+		return 1
+	case n < 0:
+		return 0
+	}
+	return n
+}
+
+// parseTOML parses the subset of TOML used by configuration files:
+// "key = value" pairs with strings, integers, booleans
+// and arrays of those. Tables are not supported.
+func parseTOML(data []byte) (map[string]interface{}, error) {
+	res := make(map[string]interface{})
+	lines := strings.Split(strings.Replace(string(data), "\r\n", "\n", -1), "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			return nil, fmt.Errorf("line %d: tables are not supported", lineNo)
+		}
+		eq := strings.Index(line, "=")
+		if eq < 0 {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		key := strings.TrimSpace(line[:eq])
+		if k, err := strconv.Unquote(key); err == nil {
+			key = k
+		}
+		if key == "" {
+			return nil, fmt.Errorf("line %d: missing key", lineNo)
+		}
+		if _, ok := res[key]; ok {
+			return nil, fmt.Errorf("line %d: duplicate key %q", lineNo, key)
+		}
+		value := strings.TrimSpace(line[eq+1:])
+		// Arrays may continue on the following lines.
+		for strings.HasPrefix(value, "[") && !arrayClosed(value) && i+1 < len(lines) {
+			i++
+			value += "\n" + lines[i]
+		}
+		v, rest, err := parseTOMLValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNo, err)
+		}
+		if rest = strings.TrimSpace(rest); rest != "" && !strings.HasPrefix(rest, "#") {
+			return nil, fmt.Errorf("line %d: unexpected %q after value", lineNo, rest)
+		}
+		res[key] = v
+	}
+	return res, nil
+}
+
+// arrayClosed returns true if the brackets in s are balanced,
+// ignoring strings and comments.
+func arrayClosed(s string) bool {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#':
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		}
+	}
+	return depth <= 0
+}
+
+// parseTOMLValue parses the value at the start of s
+// and returns the value and the remaining string.
+func parseTOMLValue(s string) (interface{}, string, error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case s == "":
+		return nil, "", fmt.Errorf("missing value")
+	case s[0] == '"':
+		for i := 1; i < len(s); i++ {
+			switch s[i] {
+			case '\\':
+				i++
+			case '"':
+				v, err := strconv.Unquote(s[:i+1])
+				if err != nil {
+					return nil, "", fmt.Errorf("invalid string %s", s[:i+1])
+				}
+				return v, s[i+1:], nil
+			}
+		}
+		return nil, "", fmt.Errorf("unterminated string")
+	case s[0] == '\'':
+		end := strings.IndexByte(s[1:], '\'')
+		if end < 0 {
+			return nil, "", fmt.Errorf("unterminated string")
+		}
+		return s[1 : end+1], s[end+2:], nil
+	case s[0] == '[':
+		values := []interface{}{}
+		s = s[1:]
+		for {
+			s = skipTOMLSpace(s)
+			if strings.HasPrefix(s, "]") {
+				return values, s[1:], nil
+			}
+			v, rest, err := parseTOMLValue(s)
+			if err != nil {
+				return nil, "", err
+			}
+			values = append(values, v)
+			s = skipTOMLSpace(rest)
+			if strings.HasPrefix(s, ",") {
+				s = s[1:]
+			} else if !strings.HasPrefix(s, "]") {
+				return nil, "", fmt.Errorf("expected , or ] in array")
+			}
+		}
+	}
+	end := strings.IndexAny(s, " \t,]#")
+	if end < 0 {
+		end = len(s)
+	}
+	word, rest := s[:end], s[end:]
+	switch word {
+	case "true":
+		return true, rest, nil
+	case "false":
+		return false, rest, nil
+	}
+	n, err := strconv.ParseInt(strings.Replace(word, "_", "", -1), 0, 64)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid value %q", word)
+	}
+	return n, rest, nil
+}
+
+// skipTOMLSpace skips whitespace, newlines and comments.
+func skipTOMLSpace(s string) string {
+	for {
+		s = strings.TrimLeft(s, " \t\r\n")
+		if !strings.HasPrefix(s, "#") {
+			return s
+		}
+		if nl := strings.IndexByte(s, '\n'); nl >= 0 {
+			s = s[nl:]
+		} else {
+			return ""
+		}
+	}
+}
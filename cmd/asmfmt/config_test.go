@@ -0,0 +1,86 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseTOML(t *testing.T) {
+	input := `# asmfmt settings
+indent = "  " # two spaces
+keep_block_comments = true
+max_blank_lines = 2
+exclude = [
+	"gen/*", # generated
+	'vendor',
+]
+`
+	got, err := parseTOML([]byte(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"indent":              "  ",
+		"keep_block_comments": true,
+		"max_blank_lines":     int64(2),
+		"exclude":             []interface{}{"gen/*", "vendor"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	for _, bad := range []string{"[table]", "indent", `indent = "x`, "a = 1\na = 2", "a = [1, 2", "a = 1 2"} {
+		if _, err := parseTOML([]byte(bad)); err == nil {
+			t.Errorf("%q: expected error", bad)
+		}
+	}
+}
+
+func TestFindConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "asmfmt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sub := filepath.Join(dir, "a", "b")
+	if err := os.MkdirAll(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "a", ".asmfmt.json"), []byte(`{"comment_column": 40, "exclude": ["gen", "b/*_test.s"]}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := findConfig(sub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg == nil || cfg.CommentColumn == nil || *cfg.CommentColumn != 40 {
+		t.Fatalf("unexpected configuration: %+v", cfg)
+	}
+	if opts := options(cfg); opts.CommentColumn != 40 {
+		t.Errorf("comment column not applied: %+v", opts)
+	}
+	for path, want := range map[string]bool{
+		filepath.Join(sub, "x_amd64.s"):       false,
+		filepath.Join(sub, "x_test.s"):        true,
+		filepath.Join(sub, "gen"):             true,
+		filepath.Join(dir, "a", "gen", "x.s"): false,
+		filepath.Join(dir, "gen"):             false,
+	} {
+		if got := cfg.excluded(path); got != want {
+			t.Errorf("excluded(%s) = %v, want %v", path, got, want)
+		}
+	}
+
+	cfg, err = findConfig(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg != nil && filepath.Dir(cfg.path) == filepath.Join(dir, "a") {
+		t.Errorf("configuration found in subdirectory")
+	}
+}
@@ -8,19 +8,33 @@ It uses tabs for indentation and blanks for alignment.
 
 Without an explicit path, it processes the standard input.  Given a file,
 it operates on that file; given a directory, it operates on all .go files in
-that directory, recursively.  (Files starting with a period are ignored.)
+that directory, recursively.  (Files starting with a period and
+symbolic links are ignored.)
 By default, asmfmt prints the reformatted sources to standard output.
 
 Usage:
 	asmfmt [flags] [path ...]
+	asmfmt [flags] lsp
 
 The flags are:
+	-check
+		Do not print reformatted sources to standard output.
+		Exit with status 1 and print the number of files to standard
+		error if any file's formatting is different from asmfmt's.
+		Errors give exit status 2. Use with -l or -d to show the files.
 	-d
 		Do not print reformatted sources to standard output.
 		If a file's formatting is different than asmfmt's, print diffs
 		to standard output.
+	-diff-context n
+		Number of unchanged lines shown around each change by -d.
+		Default is 3.
 	-e
-		Print all (including spurious) errors.
+		Print all errors. By default only the first 10 errors
+		of each file are printed.
+	-warn-unterminated
+		Report block comments, strings and character literals that are
+		not terminated as warnings instead of errors.
 	-l
 		Do not print reformatted sources to standard output.
 		If a file's formatting is different from asmfmt's, print its name
@@ -29,12 +43,123 @@ The flags are:
 		Do not print reformatted sources to standard output.
 		If a file's formatting is different from asmfmt's, overwrite it
 		with asmfmt's version.
+		Files are replaced atomically and keep their permissions.
+		Symbolic links given as arguments are not rewritten
+		and reported as errors.
+	-verify
+		Assemble the original and the formatted file with go tool asm
+		and report an error if the code is different. Files are not
+		rewritten or listed if the check fails. The architecture
+		is inferred like for -arch, or the default GOARCH is used.
+	-selfcheck
+		Format the result again and report an error at the first
+		line that changes. Files are not rewritten or listed
+		if the check fails.
+	-backup suffix
+		Keep the original of files rewritten by -w,
+		with the suffix added to the file name.
+	-j n
+		Number of files processed in parallel. Default is GOMAXPROCS.
+		Output is written in the same order as when processing
+		the files one at a time.
+	-lines start:end
+		Only format the lines from start to end of a single file.
+		Alignment is computed from the surrounding lines, so the lines
+		are formatted as if the whole file was formatted.
+	-git-diff rev
+		Only format the lines of assembler files that git reports as
+		added or changed in the working tree since the revision.
+		Paths given restrict the files to those paths.
+	-staged
+		Only format the lines of assembler files with changes staged
+		in git. Files must not have other changes in the working tree.
+		With -git-diff, the staged changes since the revision are used.
+	-json
+		Do not print reformatted sources to standard output.
+		For each file, print a JSON object on a single line with
+		"path", "changed", the "edits" needed to format the file,
+		and any "errors" and "warnings" with their position.
+		With -d, a unified "diff" is given instead of the edits.
+	-arch goarch
+		Architecture of the files. By default it is inferred from the
+		file name suffix, like _arm64.s, or the //go:build line.
+	-stdin-filename name
+		Name of the file read from standard input. It is used for
+		messages, inferring the architecture and finding the
+		configuration.
+
+Formatting options:
+	-indent string
+		String used for each level of indentation. Default is a tab.
+	-keep-block-comments
+		Do not convert single line block comments to line comments.
+	-max-blank-lines n
+		Maximum number of consecutive empty lines. Default is 1.
+	-no-comment-space
+		Do not insert a space after // in comments.
+	-no-comment-align
+		Do not align trailing comments in a block.
+	-comment-column n
+		Minimum column of trailing comments, not counting indentation.
+	-normalize-mnemonics
+		Change instructions and directives known by the Go assembler
+		to the case it uses, like movq to MOVQ. Labels and macros
+		defined in the file are not changed.
+	-normalize-operands
+		Remove whitespace inside operands, like 8 ( SI ) to 8(SI)
+		and $(1 << 3) to $(1<<3). Macros are not changed.
+	-normalize-numbers
+		Write the prefixes of integer constants in operands in lower
+		case, like 0X1F to 0x1f. Hex digits are lower case.
+		Macros and comments are not changed.
+	-upper-hex
+		Write hex digits in upper case with -normalize-numbers.
+	-pad-data
+		Pad hex values of DATA directives with zeros to the width of
+		the data with -normalize-numbers, like $0x00ff for /2.
+
+Configuration:
+	-config file
+		Use this configuration file instead of searching for one.
+	-print-config
+		Print the effective configuration for each path and exit.
+
+The lsp command runs a language server using the Language Server Protocol
+on standard input and output. It supports document and range formatting,
+diagnostics for parse errors and document symbols.
 
 Debugging support:
 	-cpuprofile filename
 		Write cpu profile to the specified file.
 
 
+Configuration files
+
+Settings can be stored in a file named .asmfmt.toml or .asmfmt.json.
+For each file, asmfmt uses the first configuration file found in the
+directory of the file or its parents. Flags given on the command line
+override the configuration file.
+
+	# .asmfmt.toml
+	indent = "\t"
+	keep_block_comments = false
+	max_blank_lines = 1
+	no_comment_space = false
+	no_comment_align = false
+	comment_column = 0
+	normalize_mnemonics = false
+	normalize_operands = false
+	normalize_numbers = false
+	upper_hex = false
+	pad_data = false
+	exclude = ["generated", "vendor/*"]
+
+Paths matching an exclude pattern are skipped when processing directories.
+Patterns are relative to the directory of the configuration file.
+Patterns without a slash also match any file or directory with that name.
+The JSON file uses the same keys.
+
+
 When asmfmt reads from standard input, it accepts either a full Assembler file
 or a program fragment.  A program fragment must be a syntactically
 valid declaration list, statement list, or expression.
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/asmfmt"
+)
+
+// gitFile is a file changed according to git,
+// with the ranges of added or changed lines.
+type gitFile struct {
+	path   string
+	ranges []asmfmt.LineRange
+}
+
+// gitChanges returns the assembler files changed in the working tree compared to rev,
+// or the changes in the index if staged is set.
+// Paths restrict the files to those paths. Files are given relative to dir.
+// Files where lines were only removed are not returned.
+func gitChanges(dir, rev string, staged bool, paths []string) ([]gitFile, error) {
+	args := []string{
+		"-c", "core.quotePath=false", "diff", "-U0", "--no-color", "--no-ext-diff",
+		"--src-prefix=a/", "--dst-prefix=b/", "--relative", "--diff-filter=ACMR",
+	}
+	if staged {
+		args = append(args, "--cached")
+	}
+	if rev != "" {
+		args = append(args, rev)
+	}
+	args = append(args, "--")
+	args = append(args, paths...)
+	out, err := runGit(dir, args...)
+	if err != nil {
+		return nil, err
+	}
+	files, err := parseGitDiff(out)
+	if err != nil || !staged {
+		return files, err
+	}
+
+	// The line numbers of staged changes are only valid
+	// for the working tree if there are no other changes.
+	args = append([]string{"-c", "core.quotePath=false", "diff", "--name-only", "--no-ext-diff", "--relative", "--"}, paths...)
+	out, err = runGit(dir, args...)
+	if err != nil {
+		return nil, err
+	}
+	unstaged := make(map[string]bool)
+	for _, name := range strings.Split(string(out), "\n") {
+		if name, err = unquoteGitPath(name); err != nil {
+			return nil, err
+		}
+		unstaged[name] = true
+	}
+	for _, f := range files {
+		if unstaged[f.path] {
+			return nil, fmt.Errorf("%s: file has both staged and unstaged changes", f.path)
+		}
+	}
+	return files, nil
+}
+
+// runGit runs git with the arguments in dir and returns the output.
+func runGit(dir string, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git: %s", msg)
+		}
+		return nil, fmt.Errorf("git: %v", err)
+	}
+	return out, nil
+}
+
+// parseGitDiff returns the assembler files in a diff without context lines,
+// with the ranges of added lines.
+func parseGitDiff(diff []byte) ([]gitFile, error) {
+	var files []gitFile
+	var cur *gitFile
+	s := bufio.NewScanner(bytes.NewReader(diff))
+	s.Buffer(nil, 1<<30)
+	for s.Scan() {
+		line := s.Text()
+		switch {
+		case strings.HasPrefix(line, "diff "):
+			cur = nil
+		case strings.HasPrefix(line, "+++ "):
+			name, err := unquoteGitPath(strings.TrimPrefix(line, "+++ "))
+			if err != nil {
+				return nil, err
+			}
+			name = strings.TrimPrefix(name, "b/")
+			base := filepath.Base(name)
+			if !strings.HasSuffix(base, ".s") || strings.HasPrefix(base, ".") {
+				continue
+			}
+			files = append(files, gitFile{path: filepath.FromSlash(name)})
+			cur = &files[len(files)-1]
+		case strings.HasPrefix(line, "@@ ") && cur != nil:
+			r, ok, err := parseHunkHeader(line)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				cur.ranges = append(cur.ranges, r)
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	// Skip files with only removed lines.
+	res := files[:0]
+	for _, f := range files {
+		if len(f.ranges) > 0 {
+			res = append(res, f)
+		}
+	}
+	return res, nil
+}
+
+// parseHunkHeader returns the added lines of a hunk header
+// "@@ -start,count +start,count @@".
+// ok is false if no lines were added.
+func parseHunkHeader(line string) (r asmfmt.LineRange, ok bool, err error) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || !strings.HasPrefix(fields[2], "+") {
+		return r, false, fmt.Errorf("git: invalid hunk header %q", line)
+	}
+	start, count := strings.TrimPrefix(fields[2], "+"), "1"
+	if idx := strings.Index(start, ","); idx >= 0 {
+		start, count = start[:idx], start[idx+1:]
+	}
+	s, err := strconv.Atoi(start)
+	if err != nil {
+		return r, false, fmt.Errorf("git: invalid hunk header %q", line)
+	}
+	n, err := strconv.Atoi(count)
+	if err != nil {
+		return r, false, fmt.Errorf("git: invalid hunk header %q", line)
+	}
+	if n == 0 {
+		return r, false, nil
+	}
+	return asmfmt.LineRange{Start: s, End: s + n - 1}, true, nil
+}
+
+// unquoteGitPath returns a path printed by git,
+// which is quoted if it contains special characters.
+func unquoteGitPath(s string) (string, error) {
+	if !strings.HasPrefix(s, `"`) {
+		return s, nil
+	}
+	res, err := strconv.Unquote(s)
+	if err != nil {
+		return "", fmt.Errorf("git: invalid path %s", s)
+	}
+	return res, nil
+}
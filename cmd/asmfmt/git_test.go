@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/klauspost/asmfmt"
+)
+
+func TestParseHunkHeader(t *testing.T) {
+	tests := []struct {
+		line string
+		want asmfmt.LineRange
+		ok   bool
+	}{
+		{line: "@@ -1,2 +1,3 @@", want: asmfmt.LineRange{Start: 1, End: 3}, ok: true},
+		{line: "@@ -4 +5 @@ TEXT ·x(SB), $0", want: asmfmt.LineRange{Start: 5, End: 5}, ok: true},
+		{line: "@@ -4,2 +3,0 @@"},
+	}
+	for _, test := range tests {
+		r, ok, err := parseHunkHeader(test.line)
+		if err != nil {
+			t.Errorf("%q: %v", test.line, err)
+			continue
+		}
+		if r != test.want || ok != test.ok {
+			t.Errorf("%q: got %v, %v, want %v, %v", test.line, r, ok, test.want, test.ok)
+		}
+	}
+	if _, _, err := parseHunkHeader("@@ -1 @@"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestGitChanges(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+	dir := t.TempDir()
+	write := func(name, content string) {
+		t.Helper()
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	git := func(args ...string) {
+		t.Helper()
+		args = append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "commit.gpgsign=false"}, args...)
+		if _, err := runGit(dir, args...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const orig = "TEXT ·a(SB),$0\nMOVQ AX,BX\nMOVQ BX,CX\nRET\n"
+	git("init", "-q")
+	write("a.s", orig)
+	write("b.s", orig)
+	write("c.txt", orig)
+	git("add", ".")
+	git("commit", "-q", "-m", "initial")
+
+	write("a.s", "TEXT ·a(SB),$0\nMOVQ AX,BX\nMOVQ BX,DX\nRET\nJMP x\n")
+	write("b.s", "TEXT ·a(SB),$0\nMOVQ BX,CX\nRET\n")
+	write("c.txt", "changed\n")
+	write("new.s", "RET\n")
+	git("add", "new.s")
+
+	files, err := gitChanges(dir, "HEAD", false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []gitFile{
+		{path: "a.s", ranges: []asmfmt.LineRange{{Start: 3, End: 3}, {Start: 5, End: 5}}},
+		{path: "new.s", ranges: []asmfmt.LineRange{{Start: 1, End: 1}}},
+	}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("got %+v, want %+v", files, want)
+	}
+
+	files, err = gitChanges(dir, "", true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := want[1:]; !reflect.DeepEqual(files, want) {
+		t.Errorf("staged: got %+v, want %+v", files, want)
+	}
+
+	// Only the changed lines are formatted.
+	defer func(r map[string][]asmfmt.LineRange) {
+		lineRanges = r
+	}(lineRanges)
+	lineRanges = map[string][]asmfmt.LineRange{filepath.Join(dir, "a.s"): want[0].ranges}
+	var out bytes.Buffer
+	if err := processFile(filepath.Join(dir, "a.s"), nil, &out, &out, false); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out.String(), "TEXT ·a(SB),$0\nMOVQ AX,BX\n\tMOVQ BX, DX\nRET\n\tJMP x\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	// Staged changes cannot be used for files with other changes.
+	write("new.s", "RET\nRET\n")
+	if _, err := gitChanges(dir, "", true, nil); err == nil {
+		t.Error("expected error for file with unstaged changes")
+	}
+}
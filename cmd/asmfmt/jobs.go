@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"io"
+)
+
+// job is a file processed by a worker.
+type job struct {
+	path   string
+	out    bytes.Buffer // Output to standard output.
+	errOut bytes.Buffer // Output to standard error.
+	err    error
+	done   chan struct{}
+}
+
+// scheduler processes files on a bounded number of goroutines.
+// Output and errors are written in the order the files were added.
+type scheduler struct {
+	workers  chan struct{}
+	pending  chan *job
+	finished chan struct{}
+}
+
+// newScheduler returns a scheduler processing up to n files at the same time.
+// Output is written to stdout and stderr, and errors are reported with report.
+func newScheduler(n int, stdout, stderr io.Writer) *scheduler {
+	if n < 1 {
+		n = 1
+	}
+	s := &scheduler{
+		workers:  make(chan struct{}, n),
+		pending:  make(chan *job, n),
+		finished: make(chan struct{}),
+	}
+	go func() {
+		defer close(s.finished)
+		for j := range s.pending {
+			<-j.done
+			stdout.Write(j.out.Bytes())
+			stderr.Write(j.errOut.Bytes())
+			if j.err != nil {
+				report(j.err)
+			}
+		}
+	}()
+	return s
+}
+
+// add a file to be processed.
+// It blocks until a worker is available.
+func (s *scheduler) add(path string) {
+	j := &job{path: path, done: make(chan struct{})}
+	s.workers <- struct{}{}
+	s.pending <- j
+	go func() {
+		defer func() {
+			<-s.workers
+			close(j.done)
+		}()
+		j.err = processFile(j.path, nil, &j.out, &j.errOut, false)
+	}()
+}
+
+// addError adds an error to be reported in order with the files.
+func (s *scheduler) addError(err error) {
+	j := &job{err: err, done: make(chan struct{})}
+	close(j.done)
+	s.pending <- j
+}
+
+// wait until all files have been processed and reported.
+func (s *scheduler) wait() {
+	close(s.pending)
+	<-s.finished
+}
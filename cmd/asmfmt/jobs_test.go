@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSchedulerOrder(t *testing.T) {
+	dir := t.TempDir()
+	var paths []string
+	var want bytes.Buffer
+	for i := 0; i < 50; i++ {
+		path := filepath.Join(dir, fmt.Sprintf("f%02d.s", i))
+		// Vary the size, so files finish out of order.
+		src := fmt.Sprintf("TEXT ·f%d(SB),$0\n", i) + strings.Repeat("MOVQ AX,BX\n", (50-i)*20) + "RET\n"
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := processFile(path, nil, &want, &want, false); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+
+	var got bytes.Buffer
+	s := newScheduler(4, &got, &got)
+	for _, path := range paths {
+		s.add(path)
+	}
+	s.wait()
+	if !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Error("output of parallel processing differs")
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/klauspost/asmfmt"
+)
+
+// fileResult is the result of processing a file, written by -json.
+type fileResult struct {
+	Path     string      `json:"path"`
+	Changed  bool        `json:"changed"`
+	Edits    []jsonEdit  `json:"edits,omitempty"`
+	Diff     string      `json:"diff,omitempty"`
+	Errors   []jsonError `json:"errors,omitempty"`
+	Warnings []jsonError `json:"warnings,omitempty"`
+}
+
+type jsonPos struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+type jsonEdit struct {
+	Start       jsonPos `json:"start"`
+	End         jsonPos `json:"end"`
+	StartOffset int     `json:"start_offset"`
+	EndOffset   int     `json:"end_offset"`
+	NewText     string  `json:"new_text"`
+}
+
+// jsonError is an error or warning.
+// Errors not in the input, such as I/O errors, have no position or kind.
+type jsonError struct {
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Kind    string `json:"kind,omitempty"`
+	Message string `json:"message"`
+}
+
+// reportedError is an error that has already been written to the output.
+// It is only counted by report.
+type reportedError struct {
+	error
+}
+
+// processJSON formats a file and writes the result as a JSON object on a single line.
+// Errors are included in the object, and returned as a reportedError.
+func processJSON(filename string, in io.Reader, out io.Writer, stdin bool) error {
+	res := fileResult{Path: filename}
+	src, formatted, err := formatFile(filename, in, stdin, func(err *asmfmt.Error) {
+		res.Warnings = append(res.Warnings, newJSONError(err))
+	})
+	if err == nil && !bytes.Equal(src, formatted) {
+		res.Changed = true
+		atomic.AddInt64(&reformatted, 1)
+		if *doDiff {
+			name := strings.TrimPrefix(filepath.ToSlash(filename), "/")
+			res.Diff = string(asmfmt.UnifiedDiff(name, src, formatted, *diffContext))
+		} else {
+			for _, e := range asmfmt.LineEdits(src, formatted) {
+				res.Edits = append(res.Edits, jsonEdit{
+					Start:       jsonPos{Line: e.Start.Line, Column: e.Start.Column},
+					End:         jsonPos{Line: e.End.Line, Column: e.End.Column},
+					StartOffset: e.StartOffset,
+					EndOffset:   e.EndOffset,
+					NewText:     e.NewText,
+				})
+			}
+		}
+		if *write {
+			err = writeFile(filename, formatted, *backup)
+		}
+	}
+	if list, ok := err.(asmfmt.ErrorList); ok {
+		for _, e := range list {
+			res.Errors = append(res.Errors, newJSONError(e))
+		}
+	} else if err != nil {
+		res.Errors = append(res.Errors, jsonError{Message: err.Error()})
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if jerr := enc.Encode(res); jerr != nil {
+		return jerr
+	}
+	if _, werr := out.Write(buf.Bytes()); werr != nil {
+		return werr
+	}
+	if err != nil {
+		return reportedError{err}
+	}
+	return nil
+}
+
+func newJSONError(err *asmfmt.Error) jsonError {
+	return jsonError{Line: err.Pos.Line, Column: err.Pos.Column, Kind: err.Kind.String(), Message: err.Msg}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	defer func(j bool, n int64) {
+		*jsonOutput, reformatted = j, n
+	}(*jsonOutput, reformatted)
+	*jsonOutput = true
+
+	dir := t.TempDir()
+	tests := []struct {
+		name, content string
+		want          fileResult
+	}{
+		{
+			name:    "formatted.s",
+			content: "TEXT ·x(SB), $0\n\tRET\n",
+			want:    fileResult{},
+		},
+		{
+			name:    "unformatted.s",
+			content: "TEXT ·x(SB), $0\n\tMOVQ AX,BX\n\tRET\n",
+			want: fileResult{
+				Changed: true,
+				Edits: []jsonEdit{{
+					Start:       jsonPos{Line: 2, Column: 1},
+					End:         jsonPos{Line: 3, Column: 1},
+					StartOffset: 17,
+					EndOffset:   29,
+					NewText:     "\tMOVQ AX, BX\n",
+				}},
+			},
+		},
+		{
+			name:    "error.s",
+			content: "TEXT ·x(SB), $0\n\tMOVQ $\"abc, AX\n",
+			want: fileResult{
+				Errors: []jsonError{{Line: 2, Column: 8, Kind: "unterminated string", Message: "string literal not terminated"}},
+			},
+		},
+	}
+	for _, test := range tests {
+		path := filepath.Join(dir, test.name)
+		if err := ioutil.WriteFile(path, []byte(test.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		var out bytes.Buffer
+		err := processFile(path, nil, &out, &out, false)
+		if _, ok := err.(reportedError); (test.want.Errors != nil) != ok {
+			t.Errorf("%s: got error %v", test.name, err)
+		}
+		if n := bytes.Count(out.Bytes(), []byte("\n")); n != 1 {
+			t.Errorf("%s: got %d lines, want 1", test.name, n)
+		}
+		var got fileResult
+		if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+			t.Fatal(err)
+		}
+		test.want.Path = path
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %+v\nwant %+v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,480 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/asmfmt"
+)
+
+// JSON-RPC error codes.
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeRequestFailed  = -32803
+)
+
+// Symbol kinds and diagnostic severities used.
+const (
+	symbolFunction = 12
+	symbolVariable = 13
+	symbolKey      = 20 // There is no kind for labels.
+
+	severityError   = 1
+	severityWarning = 2
+)
+
+type rpcMessage struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  interface{}      `json:"result,omitempty"`
+	Error   *rpcError        `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type lspPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type lspRange struct {
+	Start lspPosition `json:"start"`
+	End   lspPosition `json:"end"`
+}
+
+type textEdit struct {
+	Range   lspRange `json:"range"`
+	NewText string   `json:"newText"`
+}
+
+type diagnostic struct {
+	Range    lspRange `json:"range"`
+	Severity int      `json:"severity"`
+	Source   string   `json:"source"`
+	Message  string   `json:"message"`
+}
+
+type documentSymbol struct {
+	Name           string           `json:"name"`
+	Kind           int              `json:"kind"`
+	Range          lspRange         `json:"range"`
+	SelectionRange lspRange         `json:"selectionRange"`
+	Children       []documentSymbol `json:"children,omitempty"`
+}
+
+type textDocumentParams struct {
+	TextDocument struct {
+		URI  string `json:"uri"`
+		Text string `json:"text"`
+	} `json:"textDocument"`
+	ContentChanges []struct {
+		Text string `json:"text"`
+	} `json:"contentChanges"`
+	Range *lspRange `json:"range"`
+}
+
+// lspServer is a language server for assembler files.
+// Requests are handled in order, one at a time.
+type lspServer struct {
+	in       *bufio.Reader
+	out      io.Writer
+	docs     map[string]string // Open documents by URI.
+	shutdown bool
+}
+
+// runLSP runs a language server reading requests from in
+// and writing responses to out until the exit notification is received.
+func runLSP(in io.Reader, out io.Writer) error {
+	s := &lspServer{in: bufio.NewReader(in), out: out, docs: make(map[string]string)}
+	for {
+		msg, err := s.read()
+		if err == io.EOF {
+			return fmt.Errorf("lsp: unexpected end of input")
+		}
+		if err != nil {
+			if _, ok := err.(*json.SyntaxError); ok {
+				s.reply(nil, nil, &rpcError{Code: codeParseError, Message: err.Error()})
+				continue
+			}
+			return err
+		}
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return fmt.Errorf("lsp: exit before shutdown")
+			}
+			return nil
+		}
+		result, rerr := s.handle(msg)
+		if msg.ID != nil {
+			if err := s.reply(msg.ID, result, rerr); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// read the next message.
+func (s *lspServer) read() (*rpcMessage, error) {
+	length := -1
+	for {
+		line, err := s.in.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			break
+		}
+		if v := strings.TrimPrefix(line, "Content-Length:"); v != line {
+			length, err = strconv.Atoi(strings.TrimSpace(v))
+			if err != nil {
+				return nil, fmt.Errorf("lsp: invalid header %q", line)
+			}
+		}
+	}
+	if length < 0 {
+		return nil, fmt.Errorf("lsp: missing Content-Length header")
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(s.in, body); err != nil {
+		return nil, err
+	}
+	var msg rpcMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// write a message.
+func (s *lspServer) write(msg *rpcMessage) error {
+	msg.JSONRPC = "2.0"
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	return err
+}
+
+// reply to a request.
+func (s *lspServer) reply(id *json.RawMessage, result interface{}, rerr *rpcError) error {
+	if id == nil {
+		null := json.RawMessage("null")
+		id = &null
+	}
+	msg := &rpcMessage{ID: id, Result: result, Error: rerr}
+	if rerr == nil && result == nil {
+		// The result must be present in successful responses.
+		msg.Result = json.RawMessage("null")
+	}
+	return s.write(msg)
+}
+
+// notify sends a notification to the client.
+func (s *lspServer) notify(method string, params interface{}) error {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return s.write(&rpcMessage{Method: method, Params: data})
+}
+
+// handle a request or notification and return the result.
+func (s *lspServer) handle(msg *rpcMessage) (interface{}, *rpcError) {
+	if s.shutdown && msg.ID != nil {
+		return nil, &rpcError{Code: codeInvalidRequest, Message: "server is shutting down"}
+	}
+	var params textDocumentParams
+	if len(msg.Params) > 0 {
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+		}
+	}
+	uri := params.TextDocument.URI
+	switch msg.Method {
+	case "initialize":
+		return map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync":                1, // Full
+				"documentFormattingProvider":      true,
+				"documentRangeFormattingProvider": true,
+				"documentSymbolProvider":          true,
+			},
+			"serverInfo": map[string]string{"name": "asmfmt"},
+		}, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		s.docs[uri] = params.TextDocument.Text
+		s.diagnose(uri)
+	case "textDocument/didChange":
+		if n := len(params.ContentChanges); n > 0 {
+			s.docs[uri] = params.ContentChanges[n-1].Text
+		}
+		s.diagnose(uri)
+	case "textDocument/didClose":
+		delete(s.docs, uri)
+		s.publish(uri, []diagnostic{})
+	case "textDocument/formatting", "textDocument/rangeFormatting":
+		doc, ok := s.docs[uri]
+		if !ok {
+			return nil, &rpcError{Code: codeInvalidParams, Message: "unknown document " + uri}
+		}
+		edits, err := s.format(uri, doc, params.Range)
+		if err != nil {
+			return nil, &rpcError{Code: codeRequestFailed, Message: err.Error()}
+		}
+		return edits, nil
+	case "textDocument/documentSymbol":
+		doc, ok := s.docs[uri]
+		if !ok {
+			return nil, &rpcError{Code: codeInvalidParams, Message: "unknown document " + uri}
+		}
+		return symbols(doc), nil
+	default:
+		if msg.ID != nil {
+			return nil, &rpcError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method}
+		}
+	}
+	return nil, nil
+}
+
+// options returns the formatting options for the document.
+func (s *lspServer) options(uri string) (asmfmt.Options, error) {
+	filename := uriPath(uri)
+	dir := "."
+	if filename != "" {
+		dir = filepath.Dir(filename)
+	} else {
+		filename = uri
+	}
+	cfg, err := findConfig(dir)
+	if err != nil {
+		return asmfmt.Options{}, err
+	}
+	opts := options(cfg)
+	opts.Filename = filename
+	return opts, nil
+}
+
+// format returns the edits formatting the document.
+// If r is not nil, only the lines in the range are formatted.
+func (s *lspServer) format(uri, doc string, r *lspRange) ([]textEdit, error) {
+	opts, err := s.options(uri)
+	if err != nil {
+		return nil, err
+	}
+	lines := docLines(doc)
+	edits := []textEdit{}
+	if r == nil {
+		res, err := asmfmt.FormatEdits(strings.NewReader(doc), opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range res {
+			edits = append(edits, textEdit{
+				Range:   lspRange{Start: lspPos(lines, e.Start), End: lspPos(lines, e.End)},
+				NewText: e.NewText,
+			})
+		}
+		return edits, nil
+	}
+
+	lr := asmfmt.LineRange{Start: r.Start.Line + 1, End: r.End.Line + 1}
+	if r.End.Character == 0 && r.End.Line > r.Start.Line {
+		// The range ends at the start of the line.
+		lr.End--
+	}
+	res, err := asmfmt.FormatRange(strings.NewReader(doc), opts, lr)
+	if err != nil {
+		return nil, err
+	}
+	// Only the lines in the range are changed, so replace
+	// everything between the common prefix and suffix.
+	newLines := docLines(string(res))
+	prefix := 0
+	for prefix < len(lines) && prefix < len(newLines) && lines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	if prefix == len(lines) && prefix == len(newLines) {
+		return edits, nil
+	}
+	suffix := 0
+	for suffix < len(lines)-prefix && suffix < len(newLines)-prefix &&
+		lines[len(lines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+	end := len(lines) - suffix
+	edits = append(edits, textEdit{
+		Range: lspRange{
+			Start: lspPosition{Line: prefix},
+			End:   lspPosition{Line: end},
+		},
+		NewText: strings.Join(newLines[prefix:len(newLines)-suffix], ""),
+	})
+	if end == len(lines) && end > 0 && !strings.HasSuffix(lines[end-1], "\n") {
+		// The last line has no newline.
+		edits[0].Range.End = lspPosition{Line: end - 1, Character: utf16Len(lines[end-1])}
+	}
+	return edits, nil
+}
+
+// diagnose publishes the errors of the document.
+func (s *lspServer) diagnose(uri string) {
+	doc := s.docs[uri]
+	lines := docLines(doc)
+	diags := []diagnostic{}
+	add := func(e *asmfmt.Error, severity int) {
+		p := lspPos(lines, e.Pos)
+		diags = append(diags, diagnostic{
+			Range:    lspRange{Start: p, End: p},
+			Severity: severity,
+			Source:   "asmfmt",
+			Message:  e.Msg,
+		})
+	}
+	opts, err := s.options(uri)
+	if err == nil {
+		if *warnUnterminated {
+			opts.Warn = func(e *asmfmt.Error) {
+				add(e, severityWarning)
+			}
+		}
+		_, err = asmfmt.FormatWithOptions(strings.NewReader(doc), opts)
+	}
+	if list, ok := err.(asmfmt.ErrorList); ok {
+		for _, e := range list {
+			add(e, severityError)
+		}
+	} else if err != nil {
+		diags = append(diags, diagnostic{Severity: severityError, Source: "asmfmt", Message: err.Error()})
+	}
+	s.publish(uri, diags)
+}
+
+// publish the diagnostics of the document.
+func (s *lspServer) publish(uri string, diags []diagnostic) {
+	s.notify("textDocument/publishDiagnostics", map[string]interface{}{
+		"uri":         uri,
+		"diagnostics": diags,
+	})
+}
+
+// symbols returns the functions, globals and labels of the document.
+// Labels are children of the function they are in.
+func symbols(doc string) []documentSymbol {
+	res := []documentSymbol{}
+	f, err := asmfmt.Parse(strings.NewReader(doc))
+	if err != nil {
+		return res
+	}
+	lines := docLines(doc)
+	symbol := func(name string, kind int, n asmfmt.Node) documentSymbol {
+		p := n.Pos()
+		r := lspRange{Start: lspPos(lines, p), End: lineEnd(lines, p.Line)}
+		return documentSymbol{Name: name, Kind: kind, Range: r, SelectionRange: r}
+	}
+	label := func(n asmfmt.Node) (documentSymbol, bool) {
+		st, ok := n.(*asmfmt.Stmt)
+		if !ok || st.Kind() != asmfmt.Label {
+			return documentSymbol{}, false
+		}
+		return symbol(strings.TrimSuffix(st.Op, ":"), symbolKey, st), true
+	}
+	for _, n := range f.Nodes {
+		switch n := n.(type) {
+		case *asmfmt.Func:
+			fn := symbol(symbolName(n.Name()), symbolFunction, n)
+			for _, b := range n.Body {
+				if l, ok := label(b); ok {
+					fn.Children = append(fn.Children, l)
+				}
+				fn.Range.End = lineEnd(lines, b.Pos().Line)
+			}
+			res = append(res, fn)
+		case *asmfmt.Stmt:
+			if strings.EqualFold(n.Op, "GLOBL") && len(n.Args) > 0 {
+				res = append(res, symbol(symbolName(n.Args[0]), symbolVariable, n))
+			} else if l, ok := label(n); ok {
+				res = append(res, l)
+			}
+		}
+	}
+	return res
+}
+
+// symbolName returns the name of a symbol reference
+// without the pseudo-register, for instance "·add" for "·add(SB)".
+func symbolName(s string) string {
+	if i := strings.Index(s, "("); i > 0 {
+		return s[:i]
+	}
+	return s
+}
+
+// docLines splits the document after each newline.
+// The last line is always included, even if it is empty.
+func docLines(doc string) []string {
+	return strings.SplitAfter(doc, "\n")
+}
+
+// lspPos converts a position to a zero based line and UTF-16 column.
+func lspPos(lines []string, p asmfmt.Pos) lspPosition {
+	res := lspPosition{Line: p.Line - 1}
+	if p.Line >= 1 && p.Line <= len(lines) {
+		line := lines[p.Line-1]
+		col := p.Column - 1
+		if col > len(line) {
+			col = len(line)
+		}
+		if col > 0 {
+			res.Character = utf16Len(line[:col])
+		}
+	}
+	return res
+}
+
+// lineEnd returns the position of the end of the line, before the newline.
+func lineEnd(lines []string, line int) lspPosition {
+	s := strings.TrimRight(lines[line-1], "\r\n")
+	return lspPosition{Line: line - 1, Character: utf16Len(s)}
+}
+
+// utf16Len returns the length of s in UTF-16 code units.
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n++
+		if r >= 0x10000 {
+			// Surrogate pair
+			n++
+		}
+	}
+	return n
+}
+
+// uriPath returns the path of a file URI, or an empty string
+// if it isn't a file URI.
+func uriPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return ""
+	}
+	return filepath.FromSlash(u.Path)
+}
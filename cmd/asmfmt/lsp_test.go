@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/asmfmt"
+)
+
+// lspScript contains the messages sent by a test client.
+type lspScript struct {
+	bytes.Buffer
+	id int
+}
+
+// request adds a request and returns its id.
+func (s *lspScript) request(method string, params interface{}) int {
+	s.id++
+	s.send(map[string]interface{}{"jsonrpc": "2.0", "id": s.id, "method": method, "params": params})
+	return s.id
+}
+
+// notify adds a notification.
+func (s *lspScript) notify(method string, params interface{}) {
+	s.send(map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params})
+}
+
+func (s *lspScript) send(v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(s, "Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+type lspReply struct {
+	ID     *int            `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+// readReplies reads all messages written by the server.
+func readReplies(t *testing.T, r io.Reader) []lspReply {
+	var res []lspReply
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadString('\n')
+		if err == io.EOF {
+			return res
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "Content-Length:")))
+		if err != nil {
+			t.Fatalf("invalid header %q", line)
+		}
+		if line, _ = br.ReadString('\n'); line != "\r\n" {
+			t.Fatalf("expected empty line, got %q", line)
+		}
+		body := make([]byte, n)
+		if _, err := io.ReadFull(br, body); err != nil {
+			t.Fatal(err)
+		}
+		var reply lspReply
+		if err := json.Unmarshal(body, &reply); err != nil {
+			t.Fatal(err)
+		}
+		res = append(res, reply)
+	}
+}
+
+func TestLSP(t *testing.T) {
+	uri := "file://" + filepath.ToSlash(filepath.Join(t.TempDir(), "add_amd64.s"))
+	doc := "TEXT ·add(SB),$0\nloop:\nMOVQ AX,BX\n  JMP loop\nRET\nGLOBL tbl<>(SB),RODATA,$8\n"
+	changed := "DATA tbl<>+0(SB)/8, $\"abc\n"
+	document := map[string]string{"uri": uri}
+
+	var script lspScript
+	initID := script.request("initialize", map[string]interface{}{"capabilities": map[string]interface{}{}})
+	script.notify("initialized", map[string]interface{}{})
+	script.notify("textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "asm", "version": 1, "text": doc},
+	})
+	formatID := script.request("textDocument/formatting", map[string]interface{}{
+		"textDocument": document, "options": map[string]interface{}{"tabSize": 8, "insertSpaces": false},
+	})
+	rangeID := script.request("textDocument/rangeFormatting", map[string]interface{}{
+		"textDocument": document,
+		"range":        lspRange{Start: lspPosition{Line: 2}, End: lspPosition{Line: 3}},
+	})
+	symbolID := script.request("textDocument/documentSymbol", map[string]interface{}{"textDocument": document})
+	unknownID := script.request("textDocument/hover", map[string]interface{}{"textDocument": document})
+	script.notify("textDocument/didChange", map[string]interface{}{
+		"textDocument":   map[string]interface{}{"uri": uri, "version": 2},
+		"contentChanges": []map[string]string{{"text": changed}},
+	})
+	script.request("shutdown", nil)
+	script.notify("exit", nil)
+
+	var out bytes.Buffer
+	if err := runLSP(&script, &out); err != nil {
+		t.Fatal(err)
+	}
+	replies := readReplies(t, &out)
+	byID := make(map[int]lspReply)
+	var diagnostics [][]diagnostic
+	for _, r := range replies {
+		if r.ID != nil {
+			byID[*r.ID] = r
+			continue
+		}
+		if r.Method != "textDocument/publishDiagnostics" {
+			t.Errorf("unexpected notification %q", r.Method)
+			continue
+		}
+		var params struct {
+			Diagnostics []diagnostic `json:"diagnostics"`
+		}
+		if err := json.Unmarshal(r.Params, &params); err != nil {
+			t.Fatal(err)
+		}
+		diagnostics = append(diagnostics, params.Diagnostics)
+	}
+	if len(byID) != script.id {
+		t.Fatalf("got %d replies, want %d", len(byID), script.id)
+	}
+
+	var caps struct {
+		Capabilities map[string]interface{} `json:"capabilities"`
+	}
+	if err := json.Unmarshal(byID[initID].Result, &caps); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []string{"documentFormattingProvider", "documentRangeFormattingProvider", "documentSymbolProvider"} {
+		if caps.Capabilities[c] != true {
+			t.Errorf("capability %s not set", c)
+		}
+	}
+
+	// Applying the edits must give the formatted document.
+	var edits []textEdit
+	if err := json.Unmarshal(byID[formatID].Result, &edits); err != nil {
+		t.Fatal(err)
+	}
+	want, err := asmfmt.Format(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := applyEdits(doc, edits); got != string(want) {
+		t.Errorf("formatting gave:\n%s\nwant:\n%s", got, want)
+	}
+
+	if err := json.Unmarshal(byID[rangeID].Result, &edits); err != nil {
+		t.Fatal(err)
+	}
+	wantRange := "TEXT ·add(SB),$0\nloop:\n\tMOVQ AX, BX\n  JMP loop\nRET\nGLOBL tbl<>(SB),RODATA,$8\n"
+	if got := applyEdits(doc, edits); got != wantRange {
+		t.Errorf("range formatting gave:\n%s\nwant:\n%s", got, wantRange)
+	}
+
+	var symbols []documentSymbol
+	if err := json.Unmarshal(byID[symbolID].Result, &symbols); err != nil {
+		t.Fatal(err)
+	}
+	line := func(l, start, end int) lspRange {
+		return lspRange{Start: lspPosition{Line: l, Character: start}, End: lspPosition{Line: l, Character: end}}
+	}
+	wantSymbols := []documentSymbol{
+		{
+			Name: "·add", Kind: symbolFunction,
+			Range:          lspRange{Start: lspPosition{}, End: lspPosition{Line: 4, Character: 3}},
+			SelectionRange: line(0, 0, 16),
+			Children: []documentSymbol{
+				{Name: "loop", Kind: symbolKey, Range: line(1, 0, 5), SelectionRange: line(1, 0, 5)},
+			},
+		},
+		{Name: "tbl<>", Kind: symbolVariable, Range: line(5, 0, 25), SelectionRange: line(5, 0, 25)},
+	}
+	if !reflect.DeepEqual(symbols, wantSymbols) {
+		t.Errorf("got symbols %+v\nwant %+v", symbols, wantSymbols)
+	}
+
+	if e := byID[unknownID].Error; e == nil || e.Code != codeMethodNotFound {
+		t.Errorf("unknown method gave error %v", e)
+	}
+
+	// Diagnostics are published when opening and changing the document.
+	if len(diagnostics) != 2 {
+		t.Fatalf("got %d diagnostics notifications, want 2", len(diagnostics))
+	}
+	if len(diagnostics[0]) != 0 {
+		t.Errorf("unexpected diagnostics: %+v", diagnostics[0])
+	}
+	wantDiag := []diagnostic{{Range: line(0, 21, 21), Severity: severityError, Source: "asmfmt", Message: "string literal not terminated"}}
+	if !reflect.DeepEqual(diagnostics[1], wantDiag) {
+		t.Errorf("got diagnostics %+v\nwant %+v", diagnostics[1], wantDiag)
+	}
+}
+
+func TestLSPExitWithoutShutdown(t *testing.T) {
+	var script lspScript
+	script.request("initialize", map[string]interface{}{})
+	script.notify("exit", nil)
+	if err := runLSP(&script, ioutil.Discard); err == nil {
+		t.Error("expected error when exiting without shutdown")
+	}
+}
+
+// applyEdits applies LSP edits to the document.
+// The edits must be sorted and may not overlap.
+func applyEdits(doc string, edits []textEdit) string {
+	lines := docLines(doc)
+	offset := func(p lspPosition) int {
+		n := 0
+		for _, l := range lines[:p.Line] {
+			n += len(l)
+		}
+		// Runes are UTF-16 characters in the test documents.
+		return n + len(string([]rune(lines[p.Line])[:p.Character]))
+	}
+	var sb strings.Builder
+	last := 0
+	for _, e := range edits {
+		start := offset(e.Range.Start)
+		sb.WriteString(doc[last:start])
+		sb.WriteString(e.NewText)
+		last = offset(e.Range.End)
+	}
+	sb.WriteString(doc[last:])
+	return sb.String()
+}
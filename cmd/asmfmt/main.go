@@ -11,20 +11,52 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
+	"runtime"
 	"runtime/pprof"
+	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/klauspost/asmfmt"
 )
 
 var (
 	// main operation modes
-	list      = flag.Bool("l", false, "list files whose formatting differs from asmfmt's")
-	write     = flag.Bool("w", false, "write result to (source) file instead of stdout")
-	doDiff    = flag.Bool("d", false, "display diffs instead of rewriting files")
-	allErrors = flag.Bool("e", false, "report all errors (not just the first 10 on different lines)")
+	list             = flag.Bool("l", false, "list files whose formatting differs from asmfmt's")
+	write            = flag.Bool("w", false, "write result to (source) file instead of stdout")
+	backup           = flag.String("backup", "", "keep the original of files rewritten by -w with this suffix added to the name")
+	doDiff           = flag.Bool("d", false, "display diffs instead of rewriting files")
+	check            = flag.Bool("check", false, "exit with status 1 if any file is not formatted")
+	verify           = flag.Bool("verify", false, "check that formatting does not change the code assembled by go tool asm")
+	selfCheck        = flag.Bool("selfcheck", false, "check that formatting the result again does not change it")
+	diffContext      = flag.Int("diff-context", 3, "number of unchanged lines around changes shown by -d")
+	allErrors        = flag.Bool("e", false, "report all errors (not just the first 10 on different lines)")
+	warnUnterminated = flag.Bool("warn-unterminated", false, "report unterminated comments and literals as warnings instead of errors")
+	lines            = flag.String("lines", "", "format only the lines start:end of a single file")
+	gitDiff          = flag.String("git-diff", "", "format only the lines of assembler files changed since this git revision")
+	staged           = flag.Bool("staged", false, "format only the lines of assembler files with changes staged in git")
+	jobs             = flag.Int("j", runtime.GOMAXPROCS(0), "number of files processed in parallel")
+	jsonOutput       = flag.Bool("json", false, "write the result for each file as a JSON object")
+	arch             = flag.String("arch", "", "GOARCH of the files, instead of inferring it from file names and build constraints")
+	stdinFilename    = flag.String("stdin-filename", "", "name of the file read from standard input")
+
+	// formatting options
+	indent         = flag.String("indent", "\t", "string used for each level of indentation")
+	keepBlock      = flag.Bool("keep-block-comments", false, "do not convert single line block comments to line comments")
+	maxBlankLines  = flag.Int("max-blank-lines", 1, "maximum number of consecutive empty lines")
+	noCommentSpace = flag.Bool("no-comment-space", false, "do not insert a space after // in comments")
+	noCommentAlign = flag.Bool("no-comment-align", false, "do not align trailing comments")
+	commentColumn  = flag.Int("comment-column", 0, "minimum column of trailing comments, not counting indentation")
+	normMnemonics  = flag.Bool("normalize-mnemonics", false, "change known instructions and directives to the case used by the Go assembler")
+	normOperands   = flag.Bool("normalize-operands", false, "remove whitespace inside operands")
+	normNumbers    = flag.Bool("normalize-numbers", false, "write prefixes of integer constants in lower case, like 0x1f")
+	upperHex       = flag.Bool("upper-hex", false, "write hex digits in upper case with -normalize-numbers")
+	padData        = flag.Bool("pad-data", false, "pad hex values of DATA directives with zeros to the data width with -normalize-numbers")
+
+	// configuration
+	configFile  = flag.String("config", "", "use this configuration file instead of searching for "+strings.Join(configNames, " or "))
+	printConfig = flag.Bool("print-config", false, "print the effective configuration for each path and exit")
 
 	// debugging
 	cpuprofile = flag.String("cpuprofile", "", "write cpu profile to this file")
@@ -35,12 +67,32 @@ const (
 )
 
 var (
-	exitCode = 0
-	errors   = 0
+	exitCode    = 0
+	errors      = 0
+	reformatted int64 // Number of files whose formatting differs, updated atomically
+
+	// lineRanges contains the lines to format in each file,
+	// if set by -lines, -git-diff or -staged.
+	lineRanges map[string][]asmfmt.LineRange
 )
 
+// maxErrors is the number of errors printed for each file, unless -e is given.
+const maxErrors = 10
+
 func report(err error) {
-	fmt.Fprintln(os.Stderr, err)
+	if _, ok := err.(reportedError); ok {
+		// Already written to the output.
+	} else if list, ok := err.(asmfmt.ErrorList); ok {
+		for i, e := range list {
+			if !*allErrors && i >= maxErrors {
+				fmt.Fprintf(os.Stderr, "%s: too many errors (%d more)\n", e.Filename, len(list)-i)
+				break
+			}
+			fmt.Fprintln(os.Stderr, e)
+		}
+	} else {
+		fmt.Fprintln(os.Stderr, err)
+	}
 	errors++
 	if !*allErrors && errors >= 10 {
 		os.Exit(2)
@@ -48,79 +100,207 @@ func report(err error) {
 	exitCode = 2
 }
 
+// warn prints a warning to w.
+func warn(w io.Writer, err *asmfmt.Error) {
+	fmt.Fprintf(w, "%s:%v: warning: %s\n", err.Filename, err.Pos, err.Msg)
+}
+
 func usage() {
 	fmt.Fprintf(os.Stderr, "usage: asmfmt [flags] [path ...]\n")
+	fmt.Fprintf(os.Stderr, "       asmfmt [flags] lsp\n")
 	flag.PrintDefaults()
 	os.Exit(2)
 }
 
+// options returns the formatting options given by the configuration.
+// Flags given on the command line override the configuration.
+func options(cfg *config) asmfmt.Options {
+	var opts asmfmt.Options
+	cfg.apply(&opts)
+	opts.Arch = *arch
+	opts.SelfCheck = *selfCheck
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "indent":
+			opts.Indent = *indent
+		case "keep-block-comments":
+			opts.KeepBlockComments = *keepBlock
+		case "max-blank-lines":
+			opts.MaxBlankLines = blankLines(*maxBlankLines)
+		case "no-comment-space":
+			opts.NoCommentSpace = *noCommentSpace
+		case "no-comment-align":
+			opts.NoCommentAlign = *noCommentAlign
+		case "comment-column":
+			opts.CommentColumn = *commentColumn
+		case "normalize-mnemonics":
+			opts.NormalizeMnemonics = *normMnemonics
+		case "normalize-operands":
+			opts.NormalizeOperands = *normOperands
+		case "normalize-numbers":
+			opts.NormalizeNumbers = *normNumbers
+		case "upper-hex":
+			opts.UpperHex = *upperHex
+		case "pad-data":
+			opts.PadData = *padData
+		}
+	})
+	return opts
+}
+
 func isAsmFile(f os.FileInfo) bool {
-	// ignore non-Asm files
+	// ignore non-Asm files and symbolic links, which -w cannot rewrite
 	name := f.Name()
-	return !f.IsDir() && !strings.HasPrefix(name, ".") && strings.HasSuffix(name, ".s")
+	return f.Mode().IsRegular() && !strings.HasPrefix(name, ".") && strings.HasSuffix(name, ".s")
 }
 
+// formatFile returns the source and the formatted source of a file.
 // If in == nil, the source is the contents of the file with the given filename.
-func processFile(filename string, in io.Reader, out io.Writer, stdin bool) error {
+// Warnings are passed to warn, if enabled by -warn-unterminated.
+func formatFile(filename string, in io.Reader, stdin bool, warn func(*asmfmt.Error)) (src, res []byte, err error) {
 	if in == nil {
 		f, err := os.Open(filename)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		defer f.Close()
 		in = f
 	}
 
-	src, err := ioutil.ReadAll(in)
+	src, err = ioutil.ReadAll(in)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts, err := fileOptions(filename, stdin, warn)
+	if err != nil {
+		return src, nil, err
+	}
+	if lineRanges != nil {
+		res, err = asmfmt.FormatRange(bytes.NewBuffer(src), opts, lineRanges[filename]...)
+	} else {
+		res, err = asmfmt.FormatWithOptions(bytes.NewBuffer(src), opts)
+	}
+	if err == nil && *verify {
+		arch := opts.Arch
+		if arch == "" {
+			arch = asmfmt.InferArch(filename, src)
+		}
+		err = verifyFormat(filename, src, res, arch)
+	}
+	return src, res, err
+}
+
+// fileOptions returns the formatting options of a file from its configuration.
+// Warnings are passed to warn, if enabled by -warn-unterminated.
+func fileOptions(filename string, stdin bool, warn func(*asmfmt.Error)) (asmfmt.Options, error) {
+	cfg, err := findConfig(configDir(filename, stdin))
+	if err != nil {
+		return asmfmt.Options{}, err
+	}
+	opts := options(cfg)
+	opts.Filename = filename
+	if *warnUnterminated {
+		opts.Warn = warn
+	}
+	return opts, nil
+}
+
+// writeFormatted formats a file and writes the result to out.
+// Unlike formatFile, the source is not kept in memory.
+// Nothing is written if formatting fails.
+// If in == nil, the source is the contents of the file with the given filename.
+func writeFormatted(filename string, in io.Reader, out io.Writer, stdin bool, warn func(*asmfmt.Error)) error {
+	if in == nil {
+		f, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	opts, err := fileOptions(filename, stdin, warn)
 	if err != nil {
 		return err
 	}
+	var res bytes.Buffer
+	if err := asmfmt.FormatToWithOptions(&res, in, opts); err != nil {
+		return err
+	}
+	_, err = out.Write(res.Bytes())
+	return err
+}
 
-	res, err := asmfmt.Format(bytes.NewBuffer(src))
+// If in == nil, the source is the contents of the file with the given filename.
+// Warnings are written to errOut.
+func processFile(filename string, in io.Reader, out, errOut io.Writer, stdin bool) error {
+	if *jsonOutput {
+		return processJSON(filename, in, out, stdin)
+	}
+	warnf := func(err *asmfmt.Error) {
+		warn(errOut, err)
+	}
+	if !*list && !*write && !*doDiff && !*check && !*verify && lineRanges == nil {
+		// Only the result is written, so the source is not kept.
+		return writeFormatted(filename, in, out, stdin, warnf)
+	}
+	src, res, err := formatFile(filename, in, stdin, warnf)
 	if err != nil {
 		return err
 	}
 
 	if !bytes.Equal(src, res) {
 		// formatting has changed
+		atomic.AddInt64(&reformatted, 1)
 		if *list {
 			fmt.Fprintln(out, filename)
 		}
 		if *write {
-			err = ioutil.WriteFile(filename, res, 0644)
+			err = writeFile(filename, res, *backup)
 			if err != nil {
 				return err
 			}
 		}
 		if *doDiff {
-			data, err := diff(src, res)
-			if err != nil {
-				return fmt.Errorf("computing diff: %s", err)
-			}
-			fmt.Printf("diff %s asmfmt/%s\n", filename, filename)
-			out.Write(data)
+			name := strings.TrimPrefix(filepath.ToSlash(filename), "/")
+			out.Write(asmfmt.UnifiedDiff(name, src, res, *diffContext))
 		}
 	}
 
-	if !*list && !*write && !*doDiff {
+	if !*list && !*write && !*doDiff && !*check {
 		_, err = out.Write(res)
 	}
 
 	return err
 }
 
-func visitFile(path string, f os.FileInfo, err error) error {
-	if err == nil && isAsmFile(f) {
-		err = processFile(path, nil, os.Stdout, false)
+func visitFile(s *scheduler, path string, f os.FileInfo, err error) error {
+	if err == nil && (f.IsDir() || isAsmFile(f)) {
+		var cfg *config
+		cfg, err = findConfig(filepath.Dir(path))
+		if err == nil && cfg.excluded(path) {
+			if f.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
 	}
 	if err != nil {
-		report(err)
+		s.addError(err)
+	} else if isAsmFile(f) {
+		s.add(path)
 	}
 	return nil
 }
 
-func walkDir(path string) {
-	filepath.Walk(path, visitFile)
+func walkDir(s *scheduler, path string) {
+	filepath.Walk(path, func(p string, f os.FileInfo, err error) error {
+		if p == path && err == nil {
+			// Never exclude the given directory.
+			return nil
+		}
+		return visitFile(s, p, f, err)
+	})
 }
 
 func main() {
@@ -128,6 +308,16 @@ func main() {
 	// so that it can use defer and have them
 	// run before the exit.
 	gofmtMain()
+	if *check && reformatted > 0 {
+		if reformatted == 1 {
+			fmt.Fprintln(os.Stderr, "1 file needs formatting")
+		} else {
+			fmt.Fprintf(os.Stderr, "%d files need formatting\n", reformatted)
+		}
+		if exitCode == 0 {
+			exitCode = 1
+		}
+	}
 	os.Exit(exitCode)
 }
 
@@ -147,57 +337,127 @@ func gofmtMain() {
 		defer pprof.StopCPUProfile()
 	}
 
+	if flag.NArg() == 1 && flag.Arg(0) == "lsp" {
+		if err := runLSP(os.Stdin, os.Stdout); err != nil {
+			report(err)
+		}
+		return
+	}
+
+	if *printConfig {
+		paths := flag.Args()
+		if len(paths) == 0 {
+			paths = []string{"."}
+		}
+		for _, path := range paths {
+			if err := writeConfig(os.Stdout, path); err != nil {
+				report(err)
+			}
+		}
+		return
+	}
+
+	if *lines != "" {
+		r, err := parseLineRange(*lines)
+		if err == nil && flag.NArg() > 1 {
+			err = fmt.Errorf("-lines can only be used with a single file")
+		}
+		if err == nil && flag.NArg() == 1 {
+			if fi, serr := os.Stat(flag.Arg(0)); serr == nil && fi.IsDir() {
+				err = fmt.Errorf("-lines cannot be used with a directory")
+			}
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			exitCode = 2
+			return
+		}
+		name := stdinName()
+		if flag.NArg() == 1 {
+			name = flag.Arg(0)
+		}
+		lineRanges = map[string][]asmfmt.LineRange{name: {r}}
+	}
+
+	if *gitDiff != "" || *staged {
+		if *lines != "" {
+			fmt.Fprintln(os.Stderr, "error: cannot use -lines with -git-diff or -staged")
+			exitCode = 2
+			return
+		}
+		files, err := gitChanges("", *gitDiff, *staged, flag.Args())
+		if err != nil {
+			report(err)
+			return
+		}
+		// The ranges are set before starting any workers, which read them.
+		lineRanges = make(map[string][]asmfmt.LineRange, len(files))
+		for _, f := range files {
+			lineRanges[f.path] = f.ranges
+		}
+		s := newScheduler(*jobs, os.Stdout, os.Stderr)
+		for _, f := range files {
+			cfg, err := findConfig(filepath.Dir(f.path))
+			switch {
+			case err != nil:
+				s.addError(err)
+			case !cfg.excluded(f.path):
+				s.add(f.path)
+			}
+		}
+		s.wait()
+		return
+	}
+
 	if flag.NArg() == 0 {
 		if *write {
 			fmt.Fprintln(os.Stderr, "error: cannot use -w with standard input")
 			exitCode = 2
 			return
 		}
-		if err := processFile("<standard input>", os.Stdin, os.Stdout, true); err != nil {
+		// With -stdin-filename, the configuration is found from the file.
+		if err := processFile(stdinName(), os.Stdin, os.Stdout, os.Stderr, *stdinFilename == ""); err != nil {
 			report(err)
 		}
 		return
 	}
 
+	s := newScheduler(*jobs, os.Stdout, os.Stderr)
 	for i := 0; i < flag.NArg(); i++ {
 		path := flag.Arg(i)
 		switch dir, err := os.Stat(path); {
 		case err != nil:
-			report(err)
+			s.addError(err)
 		case dir.IsDir():
-			walkDir(path)
+			walkDir(s, path)
 		default:
-			if err := processFile(path, nil, os.Stdout, false); err != nil {
-				report(err)
-			}
+			s.add(path)
 		}
 	}
+	s.wait()
 }
 
-func diff(b1, b2 []byte) (data []byte, err error) {
-	f1, err := ioutil.TempFile("", "asmfmt")
-	if err != nil {
-		return
+// stdinName returns the name used for standard input.
+func stdinName() string {
+	if *stdinFilename != "" {
+		return *stdinFilename
 	}
-	defer os.Remove(f1.Name())
-	defer f1.Close()
+	return "<standard input>"
+}
 
-	f2, err := ioutil.TempFile("", "asmfmt")
-	if err != nil {
-		return
+// parseLineRange parses a line range given as "start:end".
+func parseLineRange(s string) (asmfmt.LineRange, error) {
+	var r asmfmt.LineRange
+	idx := strings.Index(s, ":")
+	if idx < 0 {
+		return r, fmt.Errorf("invalid line range %q, expected start:end", s)
 	}
-	defer os.Remove(f2.Name())
-	defer f2.Close()
-
-	f1.Write(b1)
-	f2.Write(b2)
-
-	data, err = exec.Command("diff", "-u", f1.Name(), f2.Name()).CombinedOutput()
-	if len(data) > 0 {
-		// diff exits with a non-zero status when the files don't match.
-		// Ignore that failure as long as we get output.
-		err = nil
+	var err error
+	if r.Start, err = strconv.Atoi(s[:idx]); err == nil {
+		r.End, err = strconv.Atoi(s[idx+1:])
 	}
-	return
-
+	if err != nil || r.Start < 1 || r.End < r.Start {
+		return r, fmt.Errorf("invalid line range %q, expected start:end", s)
+	}
+	return r, nil
 }
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/asmfmt"
+)
+
+func TestParseLineRange(t *testing.T) {
+	r, err := parseLineRange("3:7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (asmfmt.LineRange{Start: 3, End: 7}); r != want {
+		t.Errorf("got %v, want %v", r, want)
+	}
+	for _, s := range []string{"", "3", "0:2", "5:4", "a:b", "1:"} {
+		if _, err := parseLineRange(s); err == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	defer func(c bool, n int64) {
+		*check, reformatted = c, n
+	}(*check, reformatted)
+	*check, reformatted = true, 0
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"formatted.s":   "TEXT ·x(SB), $0\n\tRET\n",
+		"unformatted.s": "TEXT ·x(SB),$0\nRET\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		var out bytes.Buffer
+		if err := processFile(path, nil, &out, &out, false); err != nil {
+			t.Fatal(err)
+		}
+		if out.Len() > 0 {
+			t.Errorf("%s: unexpected output %q", name, out.String())
+		}
+	}
+	if reformatted != 1 {
+		t.Errorf("got %d files needing formatting, want 1", reformatted)
+	}
+}
+
+// Without any other output, the result is written if formatting succeeds.
+func TestWriteFormatted(t *testing.T) {
+	input := "TEXT ·f(SB),$0\nMOVQ a+0(FP), AX\nRET\n\nTEXT ·g(SB),$0\nRET\n"
+	want, err := asmfmt.Format(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := processFile("a.s", strings.NewReader(input), &out, &out, true); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != string(want) {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	// Nothing is written if an error is found after the first block.
+	for _, input := range []string{
+		"TEXT ·f(SB),$0\nRET\n\nTEXT ·g(SB),$0\nRET\n/* unterminated\n",
+		"TEXT ·f(SB),$0\nRET\n\nTEXT ·g(SB),$0\nMOVQ \"x, AX\n",
+		"package main\n\nfunc main() {\n\tprintln('x')\n}\n",
+	} {
+		var out bytes.Buffer
+		if err := processFile("a.s", strings.NewReader(input), &out, &out, true); err == nil {
+			t.Errorf("%q: expected error", input)
+		}
+		if out.Len() > 0 {
+			t.Errorf("%q: unexpected output %q", input, out.String())
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var goroot struct {
+	sync.Once
+	dir string
+	err error
+}
+
+// goRoot returns the GOROOT of the local go command.
+func goRoot() (string, error) {
+	goroot.Do(func() {
+		out, err := exec.Command("go", "env", "GOROOT").Output()
+		if err != nil {
+			goroot.err = fmt.Errorf("go env GOROOT: %v", err)
+			return
+		}
+		goroot.dir = strings.TrimSpace(string(out))
+	})
+	return goroot.dir, goroot.err
+}
+
+// verifyFormat assembles the source and the formatted source of the file
+// with the local go tool asm for arch, and returns an error
+// if the resulting code is different.
+// If arch is empty, the default GOARCH of the go command is used.
+func verifyFormat(filename string, src, res []byte, arch string) error {
+	if bytes.Equal(src, res) {
+		return nil
+	}
+	if arch == "" {
+		arch = build.Default.GOARCH
+	}
+	want, err := assemble(filename, src, arch)
+	if err != nil {
+		return fmt.Errorf("%s: cannot verify formatting: %v", filename, err)
+	}
+	got, err := assemble(filename, res, arch)
+	if err != nil {
+		return fmt.Errorf("%s: formatted file does not assemble: %v", filename, err)
+	}
+	if !bytes.Equal(want, got) {
+		return fmt.Errorf("%s: formatting changes the assembled code for %s", filename, arch)
+	}
+	return nil
+}
+
+// listingPos matches the source positions in an assembly listing.
+var listingPos = regexp.MustCompile(`\([^()\s]*:[0-9]+\)\t`)
+
+// assemble returns the assembly listing of src with the source positions
+// removed, so only the instructions, machine code and relocations are compared.
+// The source is assembled in a temporary directory with the base name of the file.
+// The directory of the file is included, so local include files are found.
+func assemble(filename string, src []byte, arch string) ([]byte, error) {
+	root, err := goRoot()
+	if err != nil {
+		return nil, err
+	}
+	dir, err := ioutil.TempDir("", "asmfmt")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, filepath.Base(filename))
+	if err := ioutil.WriteFile(name, src, 0600); err != nil {
+		return nil, err
+	}
+	goos := build.Default.GOOS
+	cmd := exec.Command("go", "tool", "asm", "-S", "-p", "main",
+		"-o", filepath.Join(dir, "out.o"),
+		"-I", filepath.Dir(filename), "-I", filepath.Join(root, "pkg", "include"),
+		"-D", "GOOS_"+goos, "-D", "GOARCH_"+arch,
+		name)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOOS="+goos, "GOARCH="+arch)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = strings.TrimSpace(string(out))
+		}
+		if msg == "" {
+			return nil, err
+		}
+		// Only the first error is reported.
+		if i := strings.IndexByte(msg, '\n'); i >= 0 {
+			msg = msg[:i]
+		}
+		return nil, fmt.Errorf("%s", strings.Replace(msg, name, filename, -1))
+	}
+	return listingPos.ReplaceAll(out, nil), nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/asmfmt"
+)
+
+func TestVerifyFormat(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+	if testing.Short() {
+		t.Skip("skipping assembler test in short mode")
+	}
+	filename := filepath.Join(t.TempDir(), "add_amd64.s")
+	src := []byte("#include \"textflag.h\"\nTEXT ·add(SB),NOSPLIT,$0-24\nMOVQ a+0(FP),AX\n\n\nADDQ b+8(FP),AX // add\nMOVQ AX,ret+16(FP)\nRET\n")
+	res, err := asmfmt.Format(strings.NewReader(string(src)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyFormat(filename, src, res, "amd64"); err != nil {
+		t.Fatal(err)
+	}
+
+	changed := []byte(strings.Replace(string(res), "ADDQ", "SUBQ", 1))
+	err = verifyFormat(filename, src, changed, "amd64")
+	if err == nil || !strings.Contains(err.Error(), "changes the assembled code") {
+		t.Errorf("changed code gave error %v", err)
+	}
+
+	invalid := []byte(strings.Replace(string(src), "RET", "RET AX, BX, CX", 1))
+	err = verifyFormat(filename, invalid, res, "amd64")
+	if err == nil || !strings.Contains(err.Error(), "cannot verify") {
+		t.Errorf("invalid source gave error %v", err)
+	}
+}
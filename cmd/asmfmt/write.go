@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// writeFile replaces the content of the file with data.
+// The data is written to a temporary file in the same directory,
+// which is renamed to filename, so the file is never partially written.
+// The permissions of the file are kept.
+// Symbolic links are not rewritten, since that would replace the link with a file.
+// If backup is not empty, the original file is kept with the suffix added to its name.
+func writeFile(filename string, data []byte, backup string) error {
+	fi, err := os.Lstat(filename)
+	if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSymlink != 0 {
+		return fmt.Errorf("%s: not rewriting symbolic link", filename)
+	}
+	if !fi.Mode().IsRegular() {
+		return fmt.Errorf("%s: not a regular file", filename)
+	}
+
+	dir, base := filepath.Dir(filename), filepath.Base(filename)
+	f, err := ioutil.TempFile(dir, "."+base+".asmfmt")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer func() {
+		// Remove the temporary file unless it was renamed.
+		if err != nil {
+			os.Remove(tmp)
+		}
+	}()
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if err = os.Chmod(tmp, fi.Mode().Perm()); err != nil {
+		return err
+	}
+	if backup != "" {
+		if err = backupFile(filename, filename+backup, fi.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	err = os.Rename(tmp, filename)
+	return err
+}
+
+// backupFile copies filename to name, replacing any existing file.
+// A hard link is used if possible.
+func backupFile(filename, name string, perm os.FileMode) error {
+	if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if os.Link(filename, name) == nil {
+		return nil
+	}
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(name, data, perm)
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestWriteFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "x.s")
+	if err := ioutil.WriteFile(path, []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeFile(path, []byte("new"), ".orig"); err != nil {
+		t.Fatal(err)
+	}
+	checkFile(t, path, "new")
+	checkFile(t, path+".orig", "old")
+	if fi, err := os.Stat(path); err != nil {
+		t.Fatal(err)
+	} else if runtime.GOOS != "windows" && fi.Mode().Perm() != 0600 {
+		t.Errorf("mode changed to %v", fi.Mode().Perm())
+	}
+
+	// The backup is replaced.
+	if err := writeFile(path, []byte("newer"), ".orig"); err != nil {
+		t.Fatal(err)
+	}
+	checkFile(t, path, "newer")
+	checkFile(t, path+".orig", "new")
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Errorf("got %d files, want 2", len(files))
+	}
+}
+
+func TestWriteFileSymlink(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target.s")
+	link := filepath.Join(dir, "link.s")
+	if err := ioutil.WriteFile(target, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(target, link); err != nil {
+		t.Skip("symbolic links not supported:", err)
+	}
+	if err := writeFile(link, []byte("new"), ""); err == nil {
+		t.Error("expected error writing symbolic link")
+	}
+	checkFile(t, target, "old")
+	if fi, err := os.Lstat(link); err != nil || fi.Mode()&os.ModeSymlink == 0 {
+		t.Error("symbolic link was replaced")
+	}
+}
+
+// Symbolic links are skipped when rewriting a directory.
+func TestWriteDirSymlink(t *testing.T) {
+	defer func(w bool, code int) {
+		*write, exitCode = w, code
+	}(*write, exitCode)
+	*write, exitCode = true, 0
+
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target.s")
+	link := filepath.Join(dir, "link.s")
+	if err := ioutil.WriteFile(target, []byte("TEXT ·x(SB),$0\nRET\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(target, link); err != nil {
+		t.Skip("symbolic links not supported:", err)
+	}
+	var out bytes.Buffer
+	s := newScheduler(1, &out, &out)
+	walkDir(s, dir)
+	s.wait()
+	if exitCode != 0 || out.Len() > 0 {
+		t.Errorf("exit code %d, output %q", exitCode, out.String())
+	}
+	checkFile(t, target, "TEXT ·x(SB), $0\n\tRET\n")
+	if fi, err := os.Lstat(link); err != nil || fi.Mode()&os.ModeSymlink == 0 {
+		t.Error("symbolic link was replaced")
+	}
+}
+
+func checkFile(t *testing.T, path, want string) {
+	t.Helper()
+	got, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Errorf("%s: got %q, want %q", path, got, want)
+	}
+}
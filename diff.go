@@ -0,0 +1,225 @@
+package asmfmt
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// lineMatch is a pair of equal lines in two inputs.
+type lineMatch struct {
+	a, b int
+}
+
+// diffLines returns a longest common subsequence of a and b
+// as pairs of matching line indexes in increasing order.
+// Myers' algorithm is used, dividing the problem at the middle snake,
+// so memory use is linear in the size of the input.
+func diffLines(a, b []string) []lineMatch {
+	var res []lineMatch
+	diffRange(a, b, 0, 0, &res)
+	return res
+}
+
+// diffRange adds the matches of a and b to res.
+// a and b start at line aoff and boff of the inputs.
+func diffRange(a, b []string, aoff, boff int, res *[]lineMatch) {
+	// Common prefix
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		*res = append(*res, lineMatch{aoff, boff})
+		a, b = a[1:], b[1:]
+		aoff++
+		boff++
+	}
+	// Common suffix
+	suffix := 0
+	for suffix < len(a) && suffix < len(b) && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	if len(a) > 0 && len(b) > 0 {
+		// With a common prefix and suffix removed, at least two edits are needed,
+		// so both halves are smaller than the input.
+		x, y, u, v := middleSnake(a, b)
+		diffRange(a[:x], b[:y], aoff, boff, res)
+		for i := 0; i < u-x; i++ {
+			*res = append(*res, lineMatch{aoff + x + i, boff + y + i})
+		}
+		diffRange(a[u:], b[v:], aoff+u, boff+v, res)
+	}
+	for i := 0; i < suffix; i++ {
+		*res = append(*res, lineMatch{aoff + len(a) + i, boff + len(b) + i})
+	}
+}
+
+// middleSnake returns the start (x, y) and end (u, v) of the middle snake
+// of the shortest edit script from a to b.
+func middleSnake(a, b []string) (x, y, u, v int) {
+	n, m := len(a), len(b)
+	delta := n - m
+	odd := delta&1 != 0
+	max := (n + m + 1) / 2
+	off := max + 1
+	// vf contains the furthest x on each forward diagonal k = x-y.
+	// vb contains the furthest x on each diagonal of the reversed inputs.
+	vf := make([]int, 2*off+1)
+	vb := make([]int, 2*off+1)
+	for d := 0; d <= max; d++ {
+		for k := -d; k <= d; k += 2 {
+			if k == -d || (k != d && vf[off+k-1] < vf[off+k+1]) {
+				x = vf[off+k+1]
+			} else {
+				x = vf[off+k-1] + 1
+			}
+			y = x - k
+			u, v = x, y
+			for u < n && v < m && a[u] == b[v] {
+				u++
+				v++
+			}
+			vf[off+k] = u
+			if kb := delta - k; odd && kb >= -(d-1) && kb <= d-1 && u+vb[off+kb] >= n {
+				return x, y, u, v
+			}
+		}
+		for k := -d; k <= d; k += 2 {
+			var xb int
+			if k == -d || (k != d && vb[off+k-1] < vb[off+k+1]) {
+				xb = vb[off+k+1]
+			} else {
+				xb = vb[off+k-1] + 1
+			}
+			yb := xb - k
+			ub, wb := xb, yb
+			for ub < n && wb < m && a[n-1-ub] == b[m-1-wb] {
+				ub++
+				wb++
+			}
+			vb[off+k] = ub
+			if kf := delta - k; !odd && kf >= -d && kf <= d && ub+vf[off+kf] >= n {
+				return n - ub, m - wb, n - xb, m - yb
+			}
+		}
+	}
+	panic("asmfmt: no middle snake found")
+}
+
+// UnifiedDiff returns the differences between a and b in unified diff format.
+// The headers use the names "a/" + name and "b/" + name.
+// context is the number of unchanged lines shown around each change.
+// If a and b are equal, nil is returned.
+func UnifiedDiff(name string, a, b []byte, context int) []byte {
+	if bytes.Equal(a, b) {
+		return nil
+	}
+	if context < 0 {
+		context = 0
+	}
+	al, bl := lineStrings(a), lineStrings(b)
+
+	// Convert the matches to a list of operations.
+	var ops []diffOp
+	ai, bi := 0, 0
+	add := func(aj, bj int) {
+		for ; ai < aj; ai++ {
+			ops = append(ops, diffOp{kind: '-', a: ai, b: bi})
+		}
+		for ; bi < bj; bi++ {
+			ops = append(ops, diffOp{kind: '+', a: ai, b: bi})
+		}
+	}
+	for _, m := range diffLines(al, bl) {
+		add(m.a, m.b)
+		ops = append(ops, diffOp{kind: ' ', a: ai, b: bi})
+		ai++
+		bi++
+	}
+	add(len(al), len(bl))
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- a/%s\n+++ b/%s\n", name, name)
+	for i := 0; i < len(ops); {
+		for i < len(ops) && ops[i].kind == ' ' {
+			i++
+		}
+		if i == len(ops) {
+			break
+		}
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		// Include following changes, if the context would overlap.
+		end := i
+		for {
+			for end < len(ops) && ops[end].kind != ' ' {
+				end++
+			}
+			next := end
+			for next < len(ops) && ops[next].kind == ' ' {
+				next++
+			}
+			if next == len(ops) || next-end > 2*context {
+				break
+			}
+			end = next
+		}
+		end += context
+		if end > len(ops) {
+			end = len(ops)
+		}
+		writeHunk(&buf, ops[start:end], al, bl)
+		i = end
+	}
+	return buf.Bytes()
+}
+
+// diffOp is a line in a unified diff.
+// kind is ' ', '-' or '+'. a and b are the indexes of the line
+// in each input, or the index of the next line if the line
+// is not present in the input.
+type diffOp struct {
+	kind byte
+	a, b int
+}
+
+// writeHunk writes a hunk with the operations.
+func writeHunk(buf *bytes.Buffer, ops []diffOp, a, b []string) {
+	na, nb := 0, 0
+	for _, op := range ops {
+		if op.kind != '+' {
+			na++
+		}
+		if op.kind != '-' {
+			nb++
+		}
+	}
+	fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(ops[0].a, na), hunkRange(ops[0].b, nb))
+	for _, op := range ops {
+		var line string
+		if op.kind == '-' {
+			line = a[op.a]
+		} else {
+			line = b[op.b]
+		}
+		buf.WriteByte(op.kind)
+		buf.WriteString(line)
+		if !strings.HasSuffix(line, "\n") {
+			buf.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange returns the range of lines starting at index start
+// as used in hunk headers.
+func hunkRange(start, n int) string {
+	switch n {
+	case 0:
+		// The line before an empty range.
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, n)
+}
@@ -0,0 +1,152 @@
+package asmfmt
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	words := []string{"a", "b", "c", "d"}
+	random := func() []string {
+		res := make([]string, rng.Intn(20))
+		for i := range res {
+			res[i] = words[rng.Intn(len(words))]
+		}
+		return res
+	}
+	for i := 0; i < 2000; i++ {
+		a, b := random(), random()
+		got := diffLines(a, b)
+		last := lineMatch{-1, -1}
+		for _, m := range got {
+			if m.a <= last.a || m.b <= last.b || a[m.a] != b[m.b] {
+				t.Fatalf("%q %q: invalid match %v in %v", a, b, m, got)
+			}
+			last = m
+		}
+		if want := lcsLength(a, b); len(got) != want {
+			t.Fatalf("%q %q: got %d matches, want %d", a, b, len(got), want)
+		}
+	}
+}
+
+// lcsLength returns the length of the longest common subsequence.
+func lcsLength(a, b []string) int {
+	l := make([][]int, len(a)+1)
+	for i := range l {
+		l[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				l[i][j] = l[i+1][j+1] + 1
+			case l[i+1][j] > l[i][j+1]:
+				l[i][j] = l[i+1][j]
+			default:
+				l[i][j] = l[i][j+1]
+			}
+		}
+	}
+	return l[0][0]
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	a := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk"
+	b := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nK\n"
+	tests := []struct {
+		a, b    string
+		context int
+		want    string
+	}{
+		{a: a, b: a, context: 3, want: ""},
+		{
+			a: a, b: b, context: 3,
+			want: `--- a/x.s
++++ b/x.s
+@@ -1,5 +1,5 @@
+ a
+-b
++B
+ c
+ d
+ e
+@@ -8,4 +8,4 @@
+ h
+ i
+ j
+-k
+\ No newline at end of file
++K
+`,
+		},
+		{
+			a: a, b: b, context: 0,
+			want: `--- a/x.s
++++ b/x.s
+@@ -2 +2 @@
+-b
++B
+@@ -11 +11 @@
+-k
+\ No newline at end of file
++K
+`,
+		},
+		{
+			// Changes with overlapping context are in the same hunk.
+			a: a, b: b, context: 4,
+			want: `--- a/x.s
++++ b/x.s
+@@ -1,11 +1,11 @@
+ a
+-b
++B
+ c
+ d
+ e
+ f
+ g
+ h
+ i
+ j
+-k
+\ No newline at end of file
++K
+`,
+		},
+		{
+			a: "", b: "a\nb\n", context: 3,
+			want: `--- a/x.s
++++ b/x.s
+@@ -0,0 +1,2 @@
++a
++b
+`,
+		},
+		{
+			a: "a\nb\n", b: "", context: 3,
+			want: `--- a/x.s
++++ b/x.s
+@@ -1,2 +0,0 @@
+-a
+-b
+`,
+		},
+		{
+			a: "a\nb\nc\n", b: "a\nc\n", context: 0,
+			want: `--- a/x.s
++++ b/x.s
+@@ -2 +1,0 @@
+-b
+`,
+		},
+	}
+	for _, test := range tests {
+		got := string(UnifiedDiff("x.s", []byte(test.a), []byte(test.b), test.context))
+		if got != test.want {
+			t.Errorf("%q -> %q, context %d: got:\n%s\nwant:\n%s", test.a, test.b, test.context, got, test.want)
+		}
+	}
+}
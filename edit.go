@@ -0,0 +1,90 @@
+package asmfmt
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// Edit replaces a range of the input with new text.
+// Edits replace complete lines.
+type Edit struct {
+	// Start and End of the replaced range. End is not included.
+	// Columns are byte offsets on the line, starting at 1.
+	Start, End Pos
+
+	// StartOffset and EndOffset are the byte offsets of Start and End.
+	StartOffset, EndOffset int
+
+	// NewText replaces the range.
+	NewText string
+}
+
+// FormatEdits formats the input and returns the edits that
+// transform the input into the formatted output.
+// The edits are sorted and do not overlap.
+// If the input is already formatted, no edits are returned.
+func FormatEdits(in io.Reader, opts Options) ([]Edit, error) {
+	src, err := ioutil.ReadAll(in)
+	if err != nil {
+		return nil, err
+	}
+	res, err := FormatWithOptions(bytes.NewReader(src), opts)
+	if err != nil {
+		return nil, err
+	}
+	return LineEdits(src, res), nil
+}
+
+// LineEdits returns the edits changing a into b.
+// The edits replace complete lines, and are sorted and do not overlap.
+func LineEdits(a, b []byte) []Edit {
+	al, bl := lineStrings(a), lineStrings(b)
+	// Offset of each line in a, including the end.
+	offsets := make([]int, len(al)+1)
+	for i, l := range al {
+		offsets[i+1] = offsets[i] + len(l)
+	}
+	var edits []Edit
+	ai, bi := 0, 0
+	add := func(aj, bj int) {
+		if ai == aj && bi == bj {
+			return
+		}
+		e := Edit{
+			StartOffset: offsets[ai],
+			EndOffset:   offsets[aj],
+			NewText:     strings.Join(bl[bi:bj], ""),
+		}
+		e.Start = linePos(al, ai)
+		e.End = linePos(al, aj)
+		edits = append(edits, e)
+	}
+	for _, m := range diffLines(al, bl) {
+		add(m.a, m.b)
+		ai, bi = m.a+1, m.b+1
+	}
+	add(len(al), len(bl))
+	return edits
+}
+
+// linePos returns the position of the start of line i.
+// If i is after the last line and it doesn't end with a newline,
+// the position after the last character is returned.
+func linePos(lines []string, i int) Pos {
+	if i == len(lines) && i > 0 && !strings.HasSuffix(lines[i-1], "\n") {
+		return Pos{Line: i, Column: len(lines[i-1]) + 1}
+	}
+	return Pos{Line: i + 1, Column: 1}
+}
+
+// lineStrings splits b after each newline.
+func lineStrings(b []byte) []string {
+	lines := splitLines(b)
+	res := make([]string, len(lines))
+	for i, l := range lines {
+		res[i] = string(l)
+	}
+	return res
+}
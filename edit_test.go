@@ -0,0 +1,79 @@
+package asmfmt
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFormatEdits(t *testing.T) {
+	input := "TEXT ·x(SB),$0\n\tMOVQ AX, BX\n\n\n\tRET"
+	edits, err := FormatEdits(strings.NewReader(input), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Edit{
+		{Start: Pos{1, 1}, End: Pos{2, 1}, StartOffset: 0, EndOffset: 16, NewText: "TEXT ·x(SB), $0\n"},
+		{Start: Pos{4, 1}, End: Pos{5, 5}, StartOffset: 30, EndOffset: 35, NewText: "\tRET\n"},
+	}
+	if !reflect.DeepEqual(edits, want) {
+		t.Errorf("got %+v\nwant %+v", edits, want)
+	}
+}
+
+// TestFormatEditsTestdata checks that applying the edits
+// to the test files gives the formatted output.
+func TestFormatEditsTestdata(t *testing.T) {
+	match, err := filepath.Glob("testdata/*.in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, in := range match {
+		src, err := ioutil.ReadFile(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := Format(bytes.NewBuffer(src))
+		if err != nil {
+			t.Fatal(in, err)
+		}
+		edits, err := FormatEdits(bytes.NewBuffer(src), Options{})
+		if err != nil {
+			t.Fatal(in, err)
+		}
+		var got []byte
+		last := 0
+		for _, e := range edits {
+			if e.StartOffset < last || e.EndOffset < e.StartOffset {
+				t.Fatalf("%s: edits overlap: %+v", in, e)
+			}
+			if s, end := offsetPos(src, e.StartOffset), offsetPos(src, e.EndOffset); s != e.Start || end != e.End {
+				t.Errorf("%s: positions %v-%v, want %v-%v", in, e.Start, e.End, s, end)
+			}
+			got = append(got, src[last:e.StartOffset]...)
+			got = append(got, e.NewText...)
+			last = e.EndOffset
+		}
+		got = append(got, src[last:]...)
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: applying edits does not give the formatted output", in)
+		}
+
+		edits, err = FormatEdits(bytes.NewBuffer(want), Options{})
+		if err != nil {
+			t.Fatal(in, err)
+		}
+		if len(edits) != 0 {
+			t.Errorf("%s: formatted output gives %d edits", in, len(edits))
+		}
+	}
+}
+
+// offsetPos returns the position of the offset in b.
+func offsetPos(b []byte, offset int) Pos {
+	line := bytes.Count(b[:offset], []byte("\n")) + 1
+	return Pos{Line: line, Column: offset - (bytes.LastIndexByte(b[:offset], '\n') + 1) + 1}
+}
@@ -0,0 +1,137 @@
+package asmfmt
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sort"
+)
+
+// ErrorKind is the type of an error.
+type ErrorKind int
+
+const (
+	// KindZeroByte is returned when the input contains a zero byte.
+	KindZeroByte ErrorKind = iota + 1
+	// KindGoFile is returned when the input looks like a Go file.
+	KindGoFile
+	// KindUnterminatedComment is returned when a block comment
+	// is not terminated at the end of the file.
+	KindUnterminatedComment
+	// KindUnterminatedString is returned when a string literal
+	// is not terminated at the end of the line.
+	KindUnterminatedString
+	// KindUnterminatedChar is returned when a character literal
+	// is not terminated at the end of the line.
+	KindUnterminatedChar
+	// KindNotIdempotent is returned by Options.SelfCheck when
+	// formatting the output again changes it.
+	KindNotIdempotent
+)
+
+// String returns the name of the kind.
+func (k ErrorKind) String() string {
+	switch k {
+	case KindZeroByte:
+		return "zero byte"
+	case KindGoFile:
+		return "go file"
+	case KindUnterminatedComment:
+		return "unterminated comment"
+	case KindUnterminatedString:
+		return "unterminated string"
+	case KindUnterminatedChar:
+		return "unterminated character"
+	case KindNotIdempotent:
+		return "not idempotent"
+	}
+	return fmt.Sprintf("ErrorKind(%d)", int(k))
+}
+
+// warning returns true if the kind can be reported as a warning.
+func (k ErrorKind) warning() bool {
+	switch k {
+	case KindUnterminatedComment, KindUnterminatedString, KindUnterminatedChar:
+		return true
+	}
+	return false
+}
+
+// Error is an error at a position in the input.
+type Error struct {
+	Filename string // Filename given in the options, if any.
+	Pos      Pos
+	Kind     ErrorKind
+	Msg      string
+}
+
+// Error returns the error as "file:line:column: message".
+// If no filename is set, it is left out.
+func (e *Error) Error() string {
+	if e.Filename != "" {
+		return fmt.Sprintf("%s:%v: %s", e.Filename, e.Pos, e.Msg)
+	}
+	return fmt.Sprintf("%v: %s", e.Pos, e.Msg)
+}
+
+// ErrorList is a list of errors sorted by position.
+// Errors in the input are returned as an ErrorList.
+type ErrorList []*Error
+
+// Error returns the first error and the number of remaining errors.
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+}
+
+// Err returns the list as an error, or nil if the list is empty.
+func (l ErrorList) Err() error {
+	if len(l) == 0 {
+		return nil
+	}
+	return l
+}
+
+// add an error to the list.
+func (l *ErrorList) add(filename string, pos Pos, kind ErrorKind, format string, a ...interface{}) {
+	*l = append(*l, &Error{Filename: filename, Pos: pos, Kind: kind, Msg: fmt.Sprintf(format, a...)})
+}
+
+// sort the list by position.
+func (l ErrorList) sort() {
+	sort.SliceStable(l, func(i, j int) bool {
+		a, b := l[i].Pos, l[j].Pos
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+}
+
+// internalError is returned when formatting panics because of a bug,
+// so programs formatting untrusted input are not crashed by it.
+type internalError struct {
+	filename string
+	value    interface{}
+	stack    []byte
+}
+
+// Error returns the panic value as "file: internal error: value".
+func (e *internalError) Error() string {
+	if e.filename != "" {
+		return fmt.Sprintf("%s: internal error: %v", e.filename, e.value)
+	}
+	return fmt.Sprintf("internal error: %v", e.value)
+}
+
+// recoverError recovers from a panic and returns it in *err.
+// It must be deferred directly.
+func recoverError(filename string, err *error) {
+	if r := recover(); r != nil {
+		*err = &internalError{filename: filename, value: r, stack: debug.Stack()}
+	}
+}
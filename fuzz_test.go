@@ -0,0 +1,142 @@
+//go:build go1.18
+// +build go1.18
+
+package asmfmt
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/klauspost/asmfmt/lexer"
+)
+
+// addSeeds adds the testdata input files to the corpus.
+func addSeeds(f *testing.F) {
+	match, err := filepath.Glob("testdata/*.in")
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, in := range match {
+		src, err := ioutil.ReadFile(in)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(src)
+	}
+}
+
+// formatFuzz formats src, and fails if formatting panics.
+// Inputs that are not valid are skipped.
+func formatFuzz(t *testing.T, src []byte, opts Options) []byte {
+	out, err := FormatWithOptions(bytes.NewReader(src), opts)
+	if ierr, ok := err.(*internalError); ok {
+		t.Fatalf("%v\n%s", ierr, ierr.stack)
+	}
+	if err != nil {
+		t.Skip(err)
+	}
+	return out
+}
+
+func FuzzFormat(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, src []byte) {
+		out := formatFuzz(t, src, Options{})
+		again, err := Format(bytes.NewReader(out))
+		if err != nil {
+			t.Fatalf("formatting the output: %v\n%s", err, out)
+		}
+		if !bytes.Equal(out, again) {
+			t.Fatalf("not idempotent:\n%s", UnifiedDiff("output", out, again, 3))
+		}
+		want, wantComments, wantQuote := fuzzTokens(src)
+		got, gotComments, gotQuote := fuzzTokens(out)
+		if wantQuote || gotQuote {
+			// Whitespace after a lone quote may change how the rest
+			// of the line is tokenized, so the tokens are compared
+			// as one without whitespace and separators.
+			want, got = []string{joinTokens(want)}, []string{joinTokens(got)}
+		}
+		if strings.Join(got, " ") != strings.Join(want, " ") {
+			t.Fatalf("tokens changed:\n%q\n%q", want, got)
+		}
+		if gotComments != wantComments {
+			t.Fatalf("comments changed:\n%q\n%q", wantComments, gotComments)
+		}
+	})
+}
+
+func FuzzFormatOptions(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, src []byte) {
+		opts := Options{
+			Warn:               func(*Error) {},
+			SelfCheck:          true,
+			MaxBlankLines:      -1,
+			KeepBlockComments:  true,
+			CommentColumn:      20,
+			NormalizeMnemonics: true,
+			NormalizeOperands:  true,
+			NormalizeNumbers:   true,
+			PadData:            true,
+		}
+		if _, err := FormatWithOptions(bytes.NewReader(src), opts); err != nil {
+			if ierr, ok := err.(*internalError); ok {
+				t.Fatalf("%v\n%s", ierr, ierr.stack)
+			}
+			if list, ok := err.(ErrorList); ok && list[0].Kind == KindNotIdempotent {
+				t.Fatal(err)
+			}
+		}
+	})
+}
+
+// fuzzTokens returns the tokens of the source except whitespace,
+// continuations and comments, and the text of the comments without whitespace
+// and comment markers, which may be changed by formatting.
+// Empty operands and trailing semicolons are removed by formatting,
+// so commas and semicolons are left out too.
+// quote is set if the source contains a character literal
+// that is not terminated.
+func fuzzTokens(src []byte) (toks []string, comments string, quote bool) {
+	var s lexer.Scanner
+	var b strings.Builder
+	for _, line := range strings.Split(string(src), "\n") {
+		for _, t := range s.Scan(strings.TrimSuffix(line, "\r")) {
+			switch t.Kind {
+			case lexer.Space, lexer.Continuation, lexer.Comma, lexer.Semicolon:
+			case lexer.LineComment:
+				b.WriteString(strings.TrimPrefix(t.Text, "//"))
+			case lexer.BlockComment:
+				b.WriteString(strings.TrimSuffix(strings.TrimPrefix(t.Text, "/*"), "*/"))
+			default:
+				text := t.Text
+				if t.Unterminated && t.Kind == lexer.Char {
+					quote = true
+				}
+				if t.Unterminated {
+					// Unterminated literals include the end of the line,
+					// which may be changed by formatting.
+					text = strings.TrimRight(strings.Join(strings.Fields(text), ""), ";")
+				}
+				toks = append(toks, text)
+			}
+		}
+	}
+	return toks, strings.Join(strings.Fields(b.String()), ""), quote
+}
+
+// joinTokens returns the tokens as one string
+// without whitespace, commas, semicolons and backslashes.
+func joinTokens(toks []string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || strings.ContainsRune(",;\\", r) {
+			return -1
+		}
+		return r
+	}, strings.Join(toks, ""))
+}
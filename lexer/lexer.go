@@ -0,0 +1,303 @@
+// Package lexer splits Go assembler source into tokens.
+//
+// Go assembler is line oriented, so the source is scanned one line at a time.
+// A Scanner keeps track of block comments spanning several lines.
+//
+// Instructions, registers, symbols and labels are all returned as Ident tokens.
+// Immediates start with a Dollar token and memory operands consist
+// of the tokens between parentheses, for instance "8(SI)(BX*4)".
+//
+// Concatenating the text of the tokens of a line gives the original line.
+package lexer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Kind is the type of a token.
+type Kind int
+
+const (
+	// Space is a sequence of whitespace.
+	Space Kind = iota
+	// Ident is an identifier: an instruction, register, symbol or label,
+	// for instance "MOVQ", "AX", "·add", "table<>" or "MOVBU.W".
+	Ident
+	// Number is a numeric constant, for instance "16", "0x10" or "1.5e3".
+	Number
+	// String is a string literal including the quotes.
+	String
+	// Char is a character literal including the quotes.
+	Char
+	// LineComment is a comment from "//" to the end of the line.
+	LineComment
+	// BlockComment is a comment from "/*" to "*/".
+	BlockComment
+	// Continuation is a backslash, continuing a macro on the next line.
+	Continuation
+	// Semicolon separates statements.
+	Semicolon
+	// Comma separates operands.
+	Comma
+	// Colon ends labels.
+	Colon
+	// LParen is an opening parenthesis.
+	LParen
+	// RParen is a closing parenthesis.
+	RParen
+	// Dollar starts immediates.
+	Dollar
+	// Hash starts preprocessor directives. "##" is also a Hash token.
+	Hash
+	// Operator is any other character or operator, for instance "+" or "<<".
+	Operator
+)
+
+var kindNames = [...]string{
+	Space:        "space",
+	Ident:        "ident",
+	Number:       "number",
+	String:       "string",
+	Char:         "char",
+	LineComment:  "line comment",
+	BlockComment: "block comment",
+	Continuation: "continuation",
+	Semicolon:    "semicolon",
+	Comma:        "comma",
+	Colon:        "colon",
+	LParen:       "lparen",
+	RParen:       "rparen",
+	Dollar:       "dollar",
+	Hash:         "hash",
+	Operator:     "operator",
+}
+
+// String returns the name of the kind.
+func (k Kind) String() string {
+	if k >= 0 && int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Token is a token on a line.
+type Token struct {
+	Kind Kind
+	Text string
+
+	// Offset is the byte offset of the token on the line.
+	Offset int
+
+	// Unterminated is set for strings, characters and block comments
+	// that are not terminated on the line.
+	// An unterminated character literal only contains the quote.
+	Unterminated bool
+}
+
+// End returns the byte offset following the token.
+func (t Token) End() int {
+	return t.Offset + len(t.Text)
+}
+
+// String returns the kind and text of the token.
+func (t Token) String() string {
+	return fmt.Sprintf("%v %q", t.Kind, t.Text)
+}
+
+// Scanner splits lines into tokens.
+// The zero value is ready to use.
+type Scanner struct {
+	// InComment is set when the last scanned line ended inside a block comment.
+	// If set, the first token of the next line is the remaining part
+	// of the block comment.
+	InComment bool
+}
+
+// Scan returns the tokens of a single line without line ending.
+func Scan(line string) []Token {
+	var s Scanner
+	return s.Scan(line)
+}
+
+// Scan returns the tokens of a single line without line ending.
+func (s *Scanner) Scan(line string) []Token {
+	var toks []Token
+	add := func(kind Kind, start, end int) {
+		toks = append(toks, Token{Kind: kind, Text: line[start:end], Offset: start})
+	}
+	i := 0
+	if s.InComment {
+		end := strings.Index(line, "*/")
+		if end < 0 {
+			return []Token{{Kind: BlockComment, Text: line, Unterminated: true}}
+		}
+		add(BlockComment, 0, end+2)
+		s.InComment = false
+		i = end + 2
+	}
+	for i < len(line) {
+		r, size := utf8.DecodeRuneInString(line[i:])
+		start := i
+		switch {
+		case unicode.IsSpace(r):
+			for i < len(line) {
+				r, size := utf8.DecodeRuneInString(line[i:])
+				if !unicode.IsSpace(r) {
+					break
+				}
+				i += size
+			}
+			add(Space, start, i)
+		case isIdentStart(r):
+			i = scanIdent(line, i)
+			add(Ident, start, i)
+		case r >= '0' && r <= '9':
+			i = scanNumber(line, i)
+			add(Number, start, i)
+		case r == '"':
+			i = scanString(line, i)
+			add(String, start, i)
+			if i == len(line) && (i-start < 2 || line[i-1] != '"' || escaped(line[start:i-1])) {
+				toks[len(toks)-1].Unterminated = true
+			}
+		case r == '\'':
+			_, _, tail, err := strconv.UnquoteChar(line[i+1:], '\'')
+			if err != nil || !strings.HasPrefix(tail, "'") {
+				add(Char, start, i+1)
+				toks[len(toks)-1].Unterminated = true
+				i++
+				break
+			}
+			i = len(line) - len(tail) + 1
+			add(Char, start, i)
+		case strings.HasPrefix(line[i:], "//"):
+			i = len(line)
+			add(LineComment, start, i)
+		case strings.HasPrefix(line[i:], "/*"):
+			rest := line[i+2:]
+			end := strings.Index(rest, "*/")
+			if end < 0 {
+				i = len(line)
+				add(BlockComment, start, i)
+				toks[len(toks)-1].Unterminated = true
+				s.InComment = true
+				break
+			}
+			if lc := strings.Index(rest, "//"); lc >= 0 && lc < end {
+				// A line comment starting before the end of the block comment
+				// takes precedence, so "/*" is not a comment.
+				i++
+				add(Operator, start, i)
+				break
+			}
+			i += end + 4
+			add(BlockComment, start, i)
+		case r == '\\':
+			i++
+			add(Continuation, start, i)
+		case r == ';':
+			i++
+			add(Semicolon, start, i)
+		case r == ',':
+			i++
+			add(Comma, start, i)
+		case r == ':':
+			i++
+			add(Colon, start, i)
+		case r == '(':
+			i++
+			add(LParen, start, i)
+		case r == ')':
+			i++
+			add(RParen, start, i)
+		case r == '$':
+			i++
+			add(Dollar, start, i)
+		case r == '#':
+			i++
+			if strings.HasPrefix(line[i:], "#") {
+				i++
+			}
+			add(Hash, start, i)
+		case strings.HasPrefix(line[i:], "<<"), strings.HasPrefix(line[i:], ">>"):
+			i += 2
+			add(Operator, start, i)
+		default:
+			i += size
+			add(Operator, start, i)
+		}
+	}
+	return toks
+}
+
+// isIdentStart returns true if r can start an identifier.
+func isIdentStart(r rune) bool {
+	return r == '_' || r == '·' || r == '∕' || unicode.IsLetter(r)
+}
+
+// scanIdent returns the end of the identifier starting at i.
+func scanIdent(line string, i int) int {
+	for i < len(line) {
+		r, size := utf8.DecodeRuneInString(line[i:])
+		if !isIdentStart(r) && r != '.' && !unicode.IsDigit(r) {
+			break
+		}
+		i += size
+	}
+	// Static symbols, for instance "table<>".
+	if strings.HasPrefix(line[i:], "<>") {
+		i += 2
+	}
+	return i
+}
+
+// scanNumber returns the end of the number starting at i.
+func scanNumber(line string, i int) int {
+	hex := strings.HasPrefix(line[i:], "0x") || strings.HasPrefix(line[i:], "0X")
+	for i < len(line) {
+		c := line[i]
+		switch {
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == '.':
+			i++
+		case (c == '+' || c == '-') && i > 0 && isExponent(line[i-1], hex):
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+func isExponent(c byte, hex bool) bool {
+	if hex {
+		return c == 'p' || c == 'P'
+	}
+	return c == 'e' || c == 'E'
+}
+
+// scanString returns the end of the string starting at i.
+// If the string is not terminated, the end of the line is returned.
+func scanString(line string, i int) int {
+	for i++; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return len(line)
+}
+
+// escaped returns true if s ends with an odd number of backslashes.
+func escaped(s string) bool {
+	n := 0
+	for n < len(s) && s[len(s)-1-n] == '\\' {
+		n++
+	}
+	return n%2 == 1
+}
@@ -0,0 +1,143 @@
+package lexer
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{
+			line: "MOVQ a+8(FP), AX",
+			want: []string{`ident "MOVQ"`, `space " "`, `ident "a"`, `operator "+"`, `number "8"`, `lparen "("`, `ident "FP"`, `rparen ")"`, `comma ","`, `space " "`, `ident "AX"`},
+		},
+		{
+			line: "TEXT ·add<>(SB),NOSPLIT,$0-24",
+			want: []string{`ident "TEXT"`, `space " "`, `ident "·add<>"`, `lparen "("`, `ident "SB"`, `rparen ")"`, `comma ","`, `ident "NOSPLIT"`, `comma ","`, `dollar "$"`, `number "0"`, `operator "-"`, `number "24"`},
+		},
+		{
+			line: "MOVOU 0x10(SI)(BX*4), X0",
+			want: []string{`ident "MOVOU"`, `space " "`, `number "0x10"`, `lparen "("`, `ident "SI"`, `rparen ")"`, `lparen "("`, `ident "BX"`, `operator "*"`, `number "4"`, `rparen ")"`, `comma ","`, `space " "`, `ident "X0"`},
+		},
+		{
+			line: `DATA x<>+0(SB)/8, $"a//b, /*c\""`,
+			want: []string{`ident "DATA"`, `space " "`, `ident "x<>"`, `operator "+"`, `number "0"`, `lparen "("`, `ident "SB"`, `rparen ")"`, `operator "/"`, `number "8"`, `comma ","`, `space " "`, `dollar "$"`, `string "\"a//b, /*c\\\"\""`},
+		},
+		{
+			line: `MOVQ $'/', AX // it's a comment`,
+			want: []string{`ident "MOVQ"`, `space " "`, `dollar "$"`, `char "'/'"`, `comma ","`, `space " "`, `ident "AX"`, `space " "`, `line comment "// it's a comment"`},
+		},
+		{
+			line: `ADDQ AX /* a, b */, BX; RET`,
+			want: []string{`ident "ADDQ"`, `space " "`, `ident "AX"`, `space " "`, `block comment "/* a, b */"`, `comma ","`, `space " "`, `ident "BX"`, `semicolon ";"`, `space " "`, `ident "RET"`},
+		},
+		{
+			line: `#define X(a) MOVQ a<<1, AX ## a \`,
+			want: []string{`hash "#"`, `ident "define"`, `space " "`, `ident "X"`, `lparen "("`, `ident "a"`, `rparen ")"`, `space " "`, `ident "MOVQ"`, `space " "`, `ident "a"`, `operator "<<"`, `number "1"`, `comma ","`, `space " "`, `ident "AX"`, `space " "`, `hash "##"`, `space " "`, `ident "a"`, `space " "`, `continuation "\\"`},
+		},
+		{
+			// A line comment inside a block comment takes precedence.
+			line: `/* RET //*/`,
+			want: []string{`operator "/"`, `operator "*"`, `space " "`, `ident "RET"`, `space " "`, `line comment "//*/"`},
+		},
+		{
+			line: `loop: MOVSD 1.5e-3, X0`,
+			want: []string{`ident "loop"`, `colon ":"`, `space " "`, `ident "MOVSD"`, `space " "`, `number "1.5e-3"`, `comma ","`, `space " "`, `ident "X0"`},
+		},
+	}
+	for _, test := range tests {
+		toks := Scan(test.line)
+		var got []string
+		for _, tok := range toks {
+			got = append(got, tok.String())
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q:\ngot  %v\nwant %v", test.line, got, test.want)
+		}
+	}
+}
+
+func TestUnterminated(t *testing.T) {
+	tests := []struct {
+		line   string
+		kind   Kind
+		offset int
+	}{
+		{line: `DATA x<>(SB)/8, $"abc`, kind: String, offset: 17},
+		{line: `DATA x<>(SB)/8, $"abc\"`, kind: String, offset: 17},
+		{line: `MOVQ $'ab', AX`, kind: Char, offset: 6},
+		{line: `MOVQ AX, BX /* first`, kind: BlockComment, offset: 12},
+	}
+	for _, test := range tests {
+		var s Scanner
+		var found bool
+		for _, tok := range s.Scan(test.line) {
+			// Only the first unterminated token is checked.
+			if tok.Unterminated {
+				if tok.Kind != test.kind || tok.Offset != test.offset {
+					t.Errorf("%q: got %v at %d, want %v at %d", test.line, tok.Kind, tok.Offset, test.kind, test.offset)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("%q: no unterminated token", test.line)
+		}
+		if s.InComment != (test.kind == BlockComment) {
+			t.Errorf("%q: InComment is %v", test.line, s.InComment)
+		}
+	}
+}
+
+func TestScanBlockComment(t *testing.T) {
+	var s Scanner
+	lines := []string{"MOVQ AX, BX /* first", "second", "third */ RET"}
+	var got []string
+	for _, line := range lines {
+		for _, tok := range s.Scan(line) {
+			got = append(got, tok.String())
+		}
+	}
+	want := []string{`ident "MOVQ"`, `space " "`, `ident "AX"`, `comma ","`, `space " "`, `ident "BX"`, `space " "`, `block comment "/* first"`, `block comment "second"`, `block comment "third */"`, `space " "`, `ident "RET"`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got  %v\nwant %v", got, want)
+	}
+	if s.InComment {
+		t.Error("InComment is set after the comment ended")
+	}
+}
+
+// TestScanTestdata checks that the tokens of all test files
+// add up to the original lines.
+func TestScanTestdata(t *testing.T) {
+	match, err := filepath.Glob("../testdata/*.in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, in := range match {
+		b, err := ioutil.ReadFile(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var s Scanner
+		for i, line := range strings.Split(string(b), "\n") {
+			var sb strings.Builder
+			for _, tok := range s.Scan(line) {
+				if tok.Offset != sb.Len() {
+					t.Errorf("%s:%d: token %v at offset %d, want %d", in, i+1, tok, tok.Offset, sb.Len())
+				}
+				sb.WriteString(tok.Text)
+			}
+			if sb.String() != line {
+				t.Errorf("%s:%d: got %q, want %q", in, i+1, sb.String(), line)
+			}
+		}
+	}
+}
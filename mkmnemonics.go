@@ -0,0 +1,193 @@
+//go:build ignore
+// +build ignore
+
+// mkmnemonics generates mnemonics.go from the instruction tables
+// of the Go assembler in GOROOT.
+//
+//	go run mkmnemonics.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// tables contains the directory in cmd/internal/obj and the
+// functions in cmd/asm/internal/arch of each table.
+var tables = []struct {
+	name  string
+	dir   string
+	funcs []string
+}{
+	{name: "arm", dir: "arm", funcs: []string{"archArm"}},
+	{name: "arm64", dir: "arm64", funcs: []string{"archArm64"}},
+	{name: "loong64", dir: "loong64", funcs: []string{"archLoong64"}},
+	{name: "mips", dir: "mips", funcs: []string{"archMips", "archMips64"}},
+	{name: "ppc64", dir: "ppc64", funcs: []string{"archPPC64"}},
+	{name: "riscv64", dir: "riscv", funcs: []string{"archRISCV64"}},
+	{name: "s390x", dir: "s390x", funcs: []string{"archS390x"}},
+	{name: "wasm", dir: "wasm", funcs: []string{"archWasm"}},
+	{name: "x86", dir: "x86", funcs: []string{"archX86"}},
+}
+
+// directives are handled by the assembler, not in the instruction tables.
+var directives = []string{"DATA", "GLOBL"}
+
+func main() {
+	src := filepath.Join(runtime.GOROOT(), "src", "cmd")
+	fset := token.NewFileSet()
+	common, err := anames(fset, filepath.Join(src, "internal", "obj"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	common = append(common, directives...)
+	arch, err := parser.ParseFile(fset, filepath.Join(src, "asm", "internal", "arch", "arch.go"), nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by mkmnemonics.go from the %s assembler. DO NOT EDIT.\n\n", runtime.Version())
+	buf.WriteString("package asmfmt\n\n")
+	buf.WriteString("// mnemonicTables contains the instructions and directives\n")
+	buf.WriteString("// of each instruction table, separated by spaces.\n")
+	buf.WriteString("var mnemonicTables = map[string]string{\n")
+	for _, t := range tables {
+		names, err := anames(fset, filepath.Join(src, "internal", "obj", t.dir))
+		if err != nil {
+			log.Fatal(err)
+		}
+		names = append(names, common...)
+		names = append(names, aliases(arch, t.funcs)...)
+		writeTable(&buf, t.name, names)
+	}
+	buf.WriteString("}\n")
+
+	res, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("mnemonics.go", res, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// anames returns the strings of the Anames tables in the package in dir.
+func anames(fset *token.FileSet, dir string) ([]string, error) {
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	var res []string
+	for _, pkg := range pkgs {
+		ast.Inspect(pkg, func(n ast.Node) bool {
+			spec, ok := n.(*ast.ValueSpec)
+			if !ok || len(spec.Names) != 1 || len(spec.Values) != 1 {
+				return true
+			}
+			if !strings.HasSuffix(strings.ToLower(spec.Names[0].Name), "anames") {
+				return true
+			}
+			if lit, ok := spec.Values[0].(*ast.CompositeLit); ok {
+				for _, elt := range lit.Elts {
+					if kv, ok := elt.(*ast.KeyValueExpr); ok {
+						elt = kv.Value
+					}
+					if s, ok := stringLit(elt); ok {
+						res = append(res, s)
+					}
+				}
+			}
+			return false
+		})
+	}
+	return res, nil
+}
+
+// aliases returns the instructions added to the instructions map in the functions.
+func aliases(f *ast.File, funcs []string) []string {
+	var res []string
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !contains(funcs, fn.Name.Name) {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			as, ok := n.(*ast.AssignStmt)
+			if !ok || len(as.Lhs) != 1 {
+				return true
+			}
+			idx, ok := as.Lhs[0].(*ast.IndexExpr)
+			if !ok {
+				return true
+			}
+			if id, ok := idx.X.(*ast.Ident); ok && id.Name == "instructions" {
+				if s, ok := stringLit(idx.Index); ok {
+					res = append(res, s)
+				}
+			}
+			return true
+		})
+	}
+	return res
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// writeTable writes the sorted unique names as a map entry.
+func writeTable(buf *bytes.Buffer, name string, names []string) {
+	sort.Strings(names)
+	var uniq []string
+	for i, s := range names {
+		// Skip markers of the start and end of the tables.
+		if s == "XXX" || s == "LAST" || strings.HasSuffix(s, "START") {
+			continue
+		}
+		if i == 0 || s != names[i-1] {
+			uniq = append(uniq, s)
+		}
+	}
+	fmt.Fprintf(buf, "%q: ", name)
+	line := ""
+	for i, s := range uniq {
+		if i < len(uniq)-1 {
+			s += " "
+		}
+		if len(line)+len(s) > 72 {
+			fmt.Fprintf(buf, "%q +\n", line)
+			line = ""
+		}
+		line += s
+	}
+	fmt.Fprintf(buf, "%q,\n", line)
+}
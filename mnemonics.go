@@ -0,0 +1,839 @@
+// Code generated by mkmnemonics.go from the go1.27.1 assembler. DO NOT EDIT.
+
+package asmfmt
+
+// mnemonicTables contains the instructions and directives
+// of each instruction table, separated by spaces.
+var mnemonicTables = map[string]string{
+	"arm": "ABSD ABSF ADC ADD ADDD ADDF AND B BCC BCS BEQ BFC BFI BFX BFXU BGE BGT " +
+		"BHI BHS BIC BL BLE BLO BLS BLT BMI BNE BPL BVC BVS BX BXRET CALL CLZ " +
+		"CMN CMP CMPD CMPF DATA DIV DIVD DIVF DIVHW DIVU DIVUHW DMB DUFFCOPY " +
+		"DUFFZERO DWORD END EOR FMULAD FMULAF FMULSD FMULSF FNMULAD FNMULAF " +
+		"FNMULSD FNMULSF FUNCDATA GETCALLERPC GLOBL JMP LDREX LDREXB LDREXD MCR " +
+		"MMUL MMULA MMULS MOD MODU MOVB MOVBS MOVBU MOVD MOVDF MOVDW MOVF MOVFD " +
+		"MOVFW MOVH MOVHS MOVHU MOVM MOVW MOVWD MOVWF MRC MUL MULA MULABB MULAD " +
+		"MULAF MULAL MULALU MULAWB MULAWT MULBB MULD MULF MULL MULLU MULS MULSD " +
+		"MULSF MULU MULWB MULWT MVN NEGD NEGF NMULAD NMULAF NMULD NMULF NMULSD " +
+		"NMULSF NOP ORR PCALIGN PCALIGNMAX PCDATA PLD RBIT RET REV REV16 REVSH " +
+		"RFE RSB RSC SBC SLL SQRTD SQRTF SRA SRL STREX STREXB STREXD SUB SUBD " +
+		"SUBF SWI SWPBU SWPW TEQ TEXT TST UNDEF WORD XTAB XTABU XTAH XTAHU",
+	"arm64": "ADC ADCS ADCSW ADCW ADD ADDPL ADDS ADDSW ADDVL ADDW ADR ADRP AESD AESE " +
+		"AESIMC AESMC AND ANDS ANDSW ANDW ASR ASRW AT AUTIA1716 AUTIASP " +
+		"AUTIB1716 AUTIBSP B BCC BCS BEQ BFI BFIW BFM BFMW BFXIL BFXILW BGE BGT " +
+		"BHI BHS BIC BICS BICSW BICW BL BLE BLO BLS BLT BMI BNE BPL BRK BTI BVC " +
+		"BVS CALL CASAD CASALB CASALD CASALH CASALW CASAW CASB CASD CASH CASLD " +
+		"CASLW CASPD CASPW CASW CBNZ CBNZW CBZ CBZW CCMN CCMNW CCMP CCMPW CINC " +
+		"CINCW CINV CINVW CLREX CLS CLSW CLZ CLZW CMN CMNW CMP CMPW CNEG CNEGW " +
+		"CRC32B CRC32CB CRC32CH CRC32CW CRC32CX CRC32H CRC32W CRC32X CSEL CSELW " +
+		"CSET CSETM CSETMW CSETW CSINC CSINCW CSINV CSINVW CSNEG CSNEGW CTERMEQ " +
+		"CTERMEQW CTERMNE CTERMNEW DATA DC DCPS1 DCPS2 DCPS3 DMB DRPS DSB " +
+		"DUFFCOPY DUFFZERO DWORD END EON EONW EOR EORW ERET EXTR EXTRW FABSD " +
+		"FABSS FADDD FADDS FCCMPD FCCMPED FCCMPES FCCMPS FCMPD FCMPED FCMPES " +
+		"FCMPS FCSELD FCSELS FCVTDH FCVTDS FCVTHD FCVTHS FCVTSD FCVTSH FCVTZSD " +
+		"FCVTZSDW FCVTZSS FCVTZSSW FCVTZUD FCVTZUDW FCVTZUS FCVTZUSW FDIVD FDIVS " +
+		"FLDPD FLDPQ FLDPS FMADDD FMADDS FMAXD FMAXNMD FMAXNMS FMAXS FMIND " +
+		"FMINNMD FMINNMS FMINS FMOVD FMOVQ FMOVS FMSUBD FMSUBS FMULD FMULS FNEGD " +
+		"FNEGS FNMADDD FNMADDS FNMSUBD FNMSUBS FNMULD FNMULS FRINTAD FRINTAS " +
+		"FRINTID FRINTIS FRINTMD FRINTMS FRINTND FRINTNS FRINTPD FRINTPS FRINTXD " +
+		"FRINTXS FRINTZD FRINTZS FSQRTD FSQRTS FSTPD FSTPQ FSTPS FSUBD FSUBS " +
+		"FUNCDATA GETCALLERPC GLOBL HINT HLT HVC IC ISB JMP LDADDAB LDADDAD " +
+		"LDADDAH LDADDALB LDADDALD LDADDALH LDADDALW LDADDAW LDADDB LDADDD " +
+		"LDADDH LDADDLB LDADDLD LDADDLH LDADDLW LDADDW LDAR LDARB LDARH LDARW " +
+		"LDAXP LDAXPW LDAXR LDAXRB LDAXRH LDAXRW LDCLRAB LDCLRAD LDCLRAH " +
+		"LDCLRALB LDCLRALD LDCLRALH LDCLRALW LDCLRAW LDCLRB LDCLRD LDCLRH " +
+		"LDCLRLB LDCLRLD LDCLRLH LDCLRLW LDCLRW LDEORAB LDEORAD LDEORAH LDEORALB " +
+		"LDEORALD LDEORALH LDEORALW LDEORAW LDEORB LDEORD LDEORH LDEORLB LDEORLD " +
+		"LDEORLH LDEORLW LDEORW LDORAB LDORAD LDORAH LDORALB LDORALD LDORALH " +
+		"LDORALW LDORAW LDORB LDORD LDORH LDORLB LDORLD LDORLH LDORLW LDORW LDP " +
+		"LDPSW LDPW LDXP LDXPW LDXR LDXRB LDXRH LDXRW LSL LSLW LSR LSRW MADD " +
+		"MADDW MNEG MNEGW MOVB MOVBU MOVD MOVH MOVHU MOVK MOVKW MOVN MOVNW MOVP " +
+		"MOVPD MOVPQ MOVPS MOVPSW MOVPW MOVW MOVWU MOVZ MOVZW MRS MSR MSUB MSUBW " +
+		"MUL MULW MVN MVNW NEG NEGS NEGSW NEGW NGC NGCS NGCSW NGCW NOOP NOP ORN " +
+		"ORNW ORR ORRW PACIASP PACIBSP PAND PANDS PBIC PBICS PBRKA PBRKAS PBRKB " +
+		"PBRKBS PBRKN PBRKNS PBRKPA PBRKPAS PBRKPB PBRKPBS PCALIGN PCALIGNMAX " +
+		"PCDATA PCNTP PDECP PEOR PEORS PFIRSTP PINCP PLASTP PLDR PNAND PNANDS " +
+		"PNOR PNORS PORN PORNS PORR PORRS PPEXT PPFALSE PPFIRST PPNEXT PPRFB " +
+		"PPRFD PPRFH PPRFW PPTEST PPTRUE PPUNPKHI PPUNPKLO PRDFFR PRDFFRS PREV " +
+		"PRFM PRFUM PSEL PSQDECP PSQDECPW PSQINCP PSQINCPW PSTR PTRN1 PTRN2 " +
+		"PUQDECP PUQDECPW PUQINCP PUQINCPW PUZP1 PUZP2 PWHILEGE PWHILEGEW " +
+		"PWHILEGT PWHILEGTW PWHILEHI PWHILEHIW PWHILEHS PWHILEHSW PWHILELE " +
+		"PWHILELEW PWHILELO PWHILELOW PWHILELS PWHILELSW PWHILELT PWHILELTW " +
+		"PWHILERW PWHILEWR PWRFFR PZIP1 PZIP2 RBIT RBITW RDVL REM REMW RET REV " +
+		"REV16 REV16W REV32 REVW ROR RORW RPRFM SB SBC SBCS SBCSW SBCW SBFIZ " +
+		"SBFIZW SBFM SBFMW SBFX SBFXW SCVTFD SCVTFS SCVTFWD SCVTFWS SDIV SDIVW " +
+		"SETFFR SEV SEVL SHA1C SHA1H SHA1M SHA1P SHA1SU0 SHA1SU1 SHA256H " +
+		"SHA256H2 SHA256SU0 SHA256SU1 SHA512H SHA512H2 SHA512SU0 SHA512SU1 " +
+		"SMADDL SMC SMNEGL SMSUBL SMULH SMULL STLR STLRB STLRH STLRW STLXP " +
+		"STLXPW STLXR STLXRB STLXRH STLXRW STP STPW STXP STXPW STXR STXRB STXRH " +
+		"STXRW SUB SUBS SUBSW SUBW SVC SWPAB SWPAD SWPAH SWPALB SWPALD SWPALH " +
+		"SWPALW SWPAW SWPB SWPD SWPH SWPLB SWPLD SWPLH SWPLW SWPW SXTB SXTBW " +
+		"SXTH SXTHW SXTW SYS SYSL TBNZ TBZ TEXT TLBI TST TSTW UBFIZ UBFIZW UBFM " +
+		"UBFMW UBFX UBFXW UCVTFD UCVTFS UCVTFWD UCVTFWS UDIV UDIVW UMADDL UMNEGL " +
+		"UMSUBL UMULH UMULL UNDEF UREM UREMW UXTB UXTBW UXTH UXTHW UXTW VABS " +
+		"VADD VADDP VADDV VAND VBCAX VBIC VBIF VBIT VBSL VCLS VCLZ VCMEQ VCMGE " +
+		"VCMGT VCMHI VCMHS VCMLE VCMLT VCMTST VCNT VDUP VEOR VEOR3 VEXT VFABS " +
+		"VFADD VFADDP VFCMEQ VFCMGE VFCMGT VFCMLE VFCMLT VFCVTL VFCVTL2 VFCVTN " +
+		"VFCVTN2 VFCVTZS VFCVTZU VFDIV VFMAX VFMAXNM VFMAXNMP VFMAXNMV VFMAXP " +
+		"VFMAXV VFMIN VFMINNM VFMINNMP VFMINNMV VFMINP VFMINV VFMLA VFMLS VFMUL " +
+		"VFNEG VFRINTM VFRINTN VFRINTP VFRINTZ VFSQRT VFSUB VLD1 VLD1R VLD2 " +
+		"VLD2R VLD3 VLD3R VLD4 VLD4R VMLA VMLS VMOV VMOVD VMOVI VMOVQ VMOVS VMUL " +
+		"VNEG VNOT VORN VORR VPMULL VPMULL2 VRAX1 VRBIT VREV16 VREV32 VREV64 " +
+		"VSCVTF VSHADD VSHL VSHRN VSHRN2 VSLI VSMAX VSMAXP VSMAXV VSMIN VSMINP " +
+		"VSMINV VSMLAL VSMLAL2 VSMLSL VSMLSL2 VSMULL VSMULL2 VSQABS VSQADD " +
+		"VSQNEG VSQSHL VSQSUB VSQXTN VSQXTN2 VSQXTUN VSQXTUN2 VSRHADD VSRI " +
+		"VSRSHR VSSHL VSSHLL VSSHLL2 VSSHR VST1 VST2 VST3 VST4 VSUB VSXTL VSXTL2 " +
+		"VTBL VTBX VTRN1 VTRN2 VUADDLV VUADDW VUADDW2 VUCVTF VUHADD VUMAX VUMAXP " +
+		"VUMAXV VUMIN VUMINP VUMINV VUMLAL VUMLAL2 VUMLSL VUMLSL2 VUMULL VUMULL2 " +
+		"VUQADD VUQSHL VUQSUB VUQXTN VUQXTN2 VURHADD VUSHL VUSHLL VUSHLL2 VUSHR " +
+		"VUSRA VUXTL VUXTL2 VUZP1 VUZP2 VXAR VXTN VXTN2 VZIP1 VZIP2 WFE WFI WORD " +
+		"YIELD ZABS ZADCLB ZADCLT ZADD ZADDHNB ZADDHNT ZADDP ZADDPT ZADDQP " +
+		"ZADDQV ZADDSUBP ZADR ZAESD ZAESDIMC ZAESE ZAESEMC ZAESIMC ZAESMC ZAND " +
+		"ZANDQV ZANDVB ZANDVD ZANDVH ZANDVS ZASR ZASRD ZASRR ZBCAX ZBDEP ZBEXT " +
+		"ZBF1CVT ZBF1CVTLT ZBF2CVT ZBF2CVTLT ZBFADD ZBFCLAMP ZBFCVT ZBFCVTN " +
+		"ZBFCVTNT ZBFDOT ZBFMAX ZBFMAXNM ZBFMIN ZBFMINNM ZBFMLA ZBFMLALB " +
+		"ZBFMLALT ZBFMLS ZBFMLSLB ZBFMLSLT ZBFMMLA ZBFMUL ZBFSCALE ZBFSUB ZBGRP " +
+		"ZBIC ZBSL ZBSL1N ZBSL2N ZCADD ZCDOT ZCLASTA ZCLASTAB ZCLASTAD ZCLASTAH " +
+		"ZCLASTAS ZCLASTAW ZCLASTB ZCLASTBB ZCLASTBD ZCLASTBH ZCLASTBS ZCLASTBW " +
+		"ZCLS ZCLZ ZCMLA ZCMPEQ ZCMPGE ZCMPGT ZCMPHI ZCMPHS ZCMPLE ZCMPLO ZCMPLS " +
+		"ZCMPLT ZCMPNE ZCNOT ZCNT ZCOMPACT ZCPY ZCPYB ZCPYD ZCPYH ZCPYS ZCPYW " +
+		"ZDECP ZDUP ZDUPM ZDUPQ ZDUPW ZEOR ZEOR3 ZEORBT ZEORQV ZEORTB ZEORVB " +
+		"ZEORVD ZEORVH ZEORVS ZEXPAND ZEXT ZEXTQ ZF1CVT ZF1CVTLT ZF2CVT ZF2CVTLT " +
+		"ZFABD ZFABS ZFACGE ZFACGT ZFADD ZFADDAD ZFADDAH ZFADDAS ZFADDP ZFADDQV " +
+		"ZFADDVD ZFADDVH ZFADDVS ZFAMAX ZFAMIN ZFCADD ZFCLAMP ZFCMEQ ZFCMGE " +
+		"ZFCMGT ZFCMLA ZFCMLE ZFCMLT ZFCMNE ZFCMUO ZFCPY ZFCVT ZFCVTLT ZFCVTN " +
+		"ZFCVTNB ZFCVTNT ZFCVTX ZFCVTXNT ZFCVTZS ZFCVTZSN ZFCVTZU ZFCVTZUN ZFDIV " +
+		"ZFDIVR ZFDOT ZFDUP ZFEXPA ZFLOGB ZFMAD ZFMAX ZFMAXNM ZFMAXNMP ZFMAXNMQV " +
+		"ZFMAXNMVD ZFMAXNMVH ZFMAXNMVS ZFMAXP ZFMAXQV ZFMAXVD ZFMAXVH ZFMAXVS " +
+		"ZFMIN ZFMINNM ZFMINNMP ZFMINNMQV ZFMINNMVD ZFMINNMVH ZFMINNMVS ZFMINP " +
+		"ZFMINQV ZFMINVD ZFMINVH ZFMINVS ZFMLA ZFMLALB ZFMLALLBB ZFMLALLBT " +
+		"ZFMLALLTB ZFMLALLTT ZFMLALT ZFMLS ZFMLSLB ZFMLSLT ZFMMLA ZFMSB ZFMUL " +
+		"ZFMULX ZFNEG ZFNMAD ZFNMLA ZFNMLS ZFNMSB ZFRECPE ZFRECPS ZFRECPX " +
+		"ZFRINT32X ZFRINT32Z ZFRINT64X ZFRINT64Z ZFRINTA ZFRINTI ZFRINTM ZFRINTN " +
+		"ZFRINTP ZFRINTX ZFRINTZ ZFRSQRTE ZFRSQRTS ZFSCALE ZFSQRT ZFSUB ZFSUBR " +
+		"ZFTMAD ZFTSMUL ZFTSSEL ZHISTCNT ZHISTSEG ZINCP ZINDEX ZINDEXW ZINSR " +
+		"ZINSRB ZINSRD ZINSRH ZINSRS ZINSRW ZLASTA ZLASTAB ZLASTAD ZLASTAH " +
+		"ZLASTAS ZLASTAW ZLASTB ZLASTBB ZLASTBD ZLASTBH ZLASTBS ZLASTBW ZLD1B " +
+		"ZLD1D ZLD1H ZLD1Q ZLD1RB ZLD1RD ZLD1RH ZLD1ROB ZLD1ROD ZLD1ROH ZLD1ROW " +
+		"ZLD1RQB ZLD1RQD ZLD1RQH ZLD1RQW ZLD1RSB ZLD1RSH ZLD1RSW ZLD1RW ZLD1SB " +
+		"ZLD1SH ZLD1SW ZLD1W ZLD2B ZLD2D ZLD2H ZLD2Q ZLD2W ZLD3B ZLD3D ZLD3H " +
+		"ZLD3Q ZLD3W ZLD4B ZLD4D ZLD4H ZLD4Q ZLD4W ZLDFF1B ZLDFF1D ZLDFF1H " +
+		"ZLDFF1SB ZLDFF1SH ZLDFF1SW ZLDFF1W ZLDNF1B ZLDNF1D ZLDNF1H ZLDNF1SB " +
+		"ZLDNF1SH ZLDNF1SW ZLDNF1W ZLDNT1B ZLDNT1D ZLDNT1H ZLDNT1SB ZLDNT1SH " +
+		"ZLDNT1SW ZLDNT1W ZLDR ZLSL ZLSLR ZLSR ZLSRR ZLUTI2 ZLUTI4 ZLUTI6 ZMAD " +
+		"ZMADPT ZMATCH ZMLA ZMLAPT ZMLS ZMOVPRFX ZMSB ZMUL ZNBSL ZNEG ZNMATCH " +
+		"ZNOT ZORQV ZORR ZORVB ZORVD ZORVH ZORVS ZPMLAL ZPMOV ZPMUL ZPMULL " +
+		"ZPMULLB ZPMULLT ZPRFB ZPRFD ZPRFH ZPRFW ZRADDHNB ZRADDHNT ZRAX1 ZRBIT " +
+		"ZREV ZREVB ZREVD ZREVH ZREVW ZRSHRNB ZRSHRNT ZRSUBHNB ZRSUBHNT ZSABA " +
+		"ZSABAL ZSABALB ZSABALT ZSABD ZSABDLB ZSABDLT ZSADALP ZSADDLB ZSADDLBT " +
+		"ZSADDLT ZSADDVD ZSADDWB ZSADDWT ZSBCLB ZSBCLT ZSCLAMP ZSCVTF ZSCVTFLT " +
+		"ZSDIV ZSDIVR ZSDOT ZSEL ZSHADD ZSHRNB ZSHRNT ZSHSUB ZSHSUBR ZSLI ZSM4E " +
+		"ZSM4EKEY ZSMAX ZSMAXP ZSMAXQV ZSMAXVB ZSMAXVD ZSMAXVH ZSMAXVS ZSMIN " +
+		"ZSMINP ZSMINQV ZSMINVB ZSMINVD ZSMINVH ZSMINVS ZSMLALB ZSMLALT ZSMLSLB " +
+		"ZSMLSLT ZSMMLA ZSMULH ZSMULLB ZSMULLT ZSPLICE ZSQABS ZSQADD ZSQCADD " +
+		"ZSQCVTN ZSQCVTUN ZSQDECP ZSQDMLALB ZSQDMLALBT ZSQDMLALT ZSQDMLSLB " +
+		"ZSQDMLSLBT ZSQDMLSLT ZSQDMULH ZSQDMULLB ZSQDMULLT ZSQINCP ZSQNEG " +
+		"ZSQRDCMLAH ZSQRDMLAH ZSQRDMLSH ZSQRDMULH ZSQRSHL ZSQRSHLR ZSQRSHRN " +
+		"ZSQRSHRNB ZSQRSHRNT ZSQRSHRUN ZSQRSHRUNB ZSQRSHRUNT ZSQSHL ZSQSHLR " +
+		"ZSQSHLU ZSQSHRN ZSQSHRNB ZSQSHRNT ZSQSHRUN ZSQSHRUNB ZSQSHRUNT ZSQSUB " +
+		"ZSQSUBR ZSQXTNB ZSQXTNT ZSQXTUNB ZSQXTUNT ZSRHADD ZSRI ZSRSHL ZSRSHLR " +
+		"ZSRSHR ZSRSRA ZSSHLLB ZSSHLLT ZSSRA ZSSUBLB ZSSUBLBT ZSSUBLT ZSSUBLTB " +
+		"ZSSUBWB ZSSUBWT ZST1B ZST1D ZST1H ZST1Q ZST1W ZST2B ZST2D ZST2H ZST2Q " +
+		"ZST2W ZST3B ZST3D ZST3H ZST3Q ZST3W ZST4B ZST4D ZST4H ZST4Q ZST4W " +
+		"ZSTNT1B ZSTNT1D ZSTNT1H ZSTNT1W ZSTR ZSUB ZSUBHNB ZSUBHNT ZSUBP ZSUBPT " +
+		"ZSUBR ZSUDOT ZSUNPKHI ZSUNPKLO ZSUQADD ZSXTB ZSXTH ZSXTW ZTBL ZTBLQ " +
+		"ZTBX ZTBXQ ZTRN1 ZTRN2 ZUABA ZUABAL ZUABALB ZUABALT ZUABD ZUABDLB " +
+		"ZUABDLT ZUADALP ZUADDLB ZUADDLT ZUADDVD ZUADDWB ZUADDWT ZUCLAMP ZUCVTF " +
+		"ZUCVTFLT ZUDIV ZUDIVR ZUDOT ZUHADD ZUHSUB ZUHSUBR ZUMAX ZUMAXP ZUMAXQV " +
+		"ZUMAXVB ZUMAXVD ZUMAXVH ZUMAXVS ZUMIN ZUMINP ZUMINQV ZUMINVB ZUMINVD " +
+		"ZUMINVH ZUMINVS ZUMLALB ZUMLALT ZUMLSLB ZUMLSLT ZUMMLA ZUMULH ZUMULLB " +
+		"ZUMULLT ZUQADD ZUQCVTN ZUQDECP ZUQINCP ZUQRSHL ZUQRSHLR ZUQRSHRN " +
+		"ZUQRSHRNB ZUQRSHRNT ZUQSHL ZUQSHLR ZUQSHRN ZUQSHRNB ZUQSHRNT ZUQSUB " +
+		"ZUQSUBR ZUQXTNB ZUQXTNT ZURECPE ZURHADD ZURSHL ZURSHLR ZURSHR ZURSQRTE " +
+		"ZURSRA ZUSDOT ZUSHLLB ZUSHLLT ZUSMMLA ZUSQADD ZUSRA ZUSUBLB ZUSUBLT " +
+		"ZUSUBWB ZUSUBWT ZUUNPKHI ZUUNPKLO ZUXTB ZUXTH ZUXTW ZUZP1 ZUZP2 ZUZPQ1 " +
+		"ZUZPQ2 ZXAR ZZIP1 ZZIP2 ZZIPQ1 ZZIPQ2",
+	"loong64": "ABSD ABSF ADD ADDD ADDF ADDV ADDV16 ADDVU ADDW ALSLV ALSLW ALSLWU " +
+		"AMADDDBV AMADDDBW AMADDV AMADDW AMANDDBV AMANDDBW AMANDV AMANDW AMCASB " +
+		"AMCASDBB AMCASDBH AMCASDBV AMCASDBW AMCASH AMCASV AMCASW AMMAXDBV " +
+		"AMMAXDBVU AMMAXDBW AMMAXDBWU AMMAXV AMMAXVU AMMAXW AMMAXWU AMMINDBV " +
+		"AMMINDBVU AMMINDBW AMMINDBWU AMMINV AMMINVU AMMINW AMMINWU AMORDBV " +
+		"AMORDBW AMORV AMORW AMSWAPB AMSWAPDBB AMSWAPDBH AMSWAPDBV AMSWAPDBW " +
+		"AMSWAPH AMSWAPV AMSWAPW AMXORDBV AMXORDBW AMXORV AMXORW AND ANDN BEQ " +
+		"BFPF BFPT BGE BGEU BGEZ BGTZ BITREV4B BITREV8B BITREVV BITREVW BLEZ BLT " +
+		"BLTU BLTZ BNE BREAK BSTRINSV BSTRINSW BSTRPICKV BSTRPICKW CALL CLOV " +
+		"CLOW CLZV CLZW CMPEQD CMPEQF CMPGED CMPGEF CMPGTD CMPGTF CPUCFG CRCCWBW " +
+		"CRCCWHW CRCCWVW CRCCWWW CRCWBW CRCWHW CRCWVW CRCWWW CTOV CTOW CTZV CTZW " +
+		"DATA DBAR DIV DIVD DIVF DIVU DIVV DIVVU DIVW DIVWU DUFFCOPY DUFFZERO " +
+		"END EXTWB EXTWH FCLASSD FCLASSF FCOPYSGD FCOPYSGF FFINTDV FFINTDW " +
+		"FFINTFV FFINTFW FLOGBD FLOGBF FMADDD FMADDF FMAXAD FMAXAF FMAXD FMAXF " +
+		"FMINAD FMINAF FMIND FMINF FMSUBD FMSUBF FNMADDD FNMADDF FNMSUBD FNMSUBF " +
+		"FRINTD FRINTF FSCALEBD FSCALEBF FSEL FTINTRMVD FTINTRMVF FTINTRMWD " +
+		"FTINTRMWF FTINTRNEVD FTINTRNEVF FTINTRNEWD FTINTRNEWF FTINTRPVD " +
+		"FTINTRPVF FTINTRPWD FTINTRPWF FTINTRZVD FTINTRZVF FTINTRZWD FTINTRZWF " +
+		"FTINTVD FTINTVF FTINTWD FTINTWF FUNCDATA GETCALLERPC GLOBL JAL JIRL JMP " +
+		"LL LLACQV LLACQW LLV LLW LU12IW LU32ID LU52ID LUI MASKEQZ MASKNEZ MOVB " +
+		"MOVBU MOVD MOVDF MOVDV MOVDW MOVF MOVFD MOVFV MOVFW MOVH MOVHU MOVV " +
+		"MOVVD MOVVF MOVVP MOVW MOVWD MOVWF MOVWP MOVWU MUL MULD MULF MULH MULHU " +
+		"MULHV MULHVU MULV MULVU MULW MULWVW MULWVWU NEGD NEGF NEGV NEGW NOOP " +
+		"NOP NOR OR ORN PCADDU12I PCALAU12I PCALIGN PCALIGNMAX PCDATA PRELD " +
+		"PRELDX RDTIMED RDTIMEHW RDTIMELW REM REMU REMV REMVU REMW REMWU RET " +
+		"REVB2H REVB2W REVB4H REVBV REVH2W REVHV RFE ROTR ROTRV SC SCQ SCRELV " +
+		"SCRELW SCV SCW SGT SGTU SLL SLLV SQRTD SQRTF SRA SRAV SRL SRLV SUB SUBD " +
+		"SUBF SUBV SUBVU SUBW SYSCALL TEQ TEXT TNE TRUNCDV TRUNCDW TRUNCFV " +
+		"TRUNCFW UNDEF VADDB VADDBU VADDD VADDF VADDH VADDHU VADDQ VADDV VADDVU " +
+		"VADDW VADDWEVHB VADDWEVHBU VADDWEVQV VADDWEVQVU VADDWEVVW VADDWEVVWU " +
+		"VADDWEVWH VADDWEVWHU VADDWODHB VADDWODHBU VADDWODQV VADDWODQVU " +
+		"VADDWODVW VADDWODVWU VADDWODWH VADDWODWHU VADDWU VANDB VANDNV VANDV " +
+		"VBITCLRB VBITCLRH VBITCLRV VBITCLRW VBITREVB VBITREVH VBITREVV VBITREVW " +
+		"VBITSETB VBITSETH VBITSETV VBITSETW VDIVB VDIVBU VDIVD VDIVF VDIVH " +
+		"VDIVHU VDIVV VDIVVU VDIVW VDIVWU VEXTRINSB VEXTRINSH VEXTRINSV " +
+		"VEXTRINSW VFCLASSD VFCLASSF VFRECIPD VFRECIPF VFRINTD VFRINTF VFRINTRMD " +
+		"VFRINTRMF VFRINTRNED VFRINTRNEF VFRINTRPD VFRINTRPF VFRINTRZD VFRINTRZF " +
+		"VFRSQRTD VFRSQRTF VFSQRTD VFSQRTF VILVHB VILVHH VILVHV VILVHW VILVLB " +
+		"VILVLH VILVLV VILVLW VMADDB VMADDH VMADDV VMADDW VMADDWEVHB VMADDWEVHBU " +
+		"VMADDWEVHBUB VMADDWEVQV VMADDWEVQVU VMADDWEVQVUV VMADDWEVVW VMADDWEVVWU " +
+		"VMADDWEVVWUW VMADDWEVWH VMADDWEVWHU VMADDWEVWHUH VMADDWODHB VMADDWODHBU " +
+		"VMADDWODHBUB VMADDWODQV VMADDWODQVU VMADDWODQVUV VMADDWODVW VMADDWODVWU " +
+		"VMADDWODVWUW VMADDWODWH VMADDWODWHU VMADDWODWHUH VMODB VMODBU VMODH " +
+		"VMODHU VMODV VMODVU VMODW VMODWU VMOVQ VMSUBB VMSUBH VMSUBV VMSUBW " +
+		"VMUHB VMUHBU VMUHH VMUHHU VMUHV VMUHVU VMUHW VMUHWU VMULB VMULD VMULF " +
+		"VMULH VMULV VMULW VMULWEVHB VMULWEVHBU VMULWEVHBUB VMULWEVQV VMULWEVQVU " +
+		"VMULWEVQVUV VMULWEVVW VMULWEVVWU VMULWEVVWUW VMULWEVWH VMULWEVWHU " +
+		"VMULWEVWHUH VMULWODHB VMULWODHBU VMULWODHBUB VMULWODQV VMULWODQVU " +
+		"VMULWODQVUV VMULWODVW VMULWODVWU VMULWODVWUW VMULWODWH VMULWODWHU " +
+		"VMULWODWHUH VNEGB VNEGH VNEGV VNEGW VNORB VNORV VORB VORNV VORV VPCNTB " +
+		"VPCNTH VPCNTV VPCNTW VPERMIW VROTRB VROTRH VROTRV VROTRW VSADDB VSADDBU " +
+		"VSADDH VSADDHU VSADDV VSADDVU VSADDW VSADDWU VSEQB VSEQH VSEQV VSEQW " +
+		"VSETALLNEB VSETALLNEH VSETALLNEV VSETALLNEW VSETANYEQB VSETANYEQH " +
+		"VSETANYEQV VSETANYEQW VSETEQV VSETNEV VSHUF4IB VSHUF4IH VSHUF4IV " +
+		"VSHUF4IW VSHUFB VSHUFH VSHUFV VSHUFW VSLLB VSLLH VSLLV VSLLW VSLTB " +
+		"VSLTBU VSLTH VSLTHU VSLTV VSLTVU VSLTW VSLTWU VSRAB VSRAH VSRAV VSRAW " +
+		"VSRLB VSRLH VSRLV VSRLW VSSUBB VSSUBBU VSSUBH VSSUBHU VSSUBV VSSUBVU " +
+		"VSSUBW VSSUBWU VSUBB VSUBBU VSUBD VSUBF VSUBH VSUBHU VSUBQ VSUBV VSUBVU " +
+		"VSUBW VSUBWEVHB VSUBWEVHBU VSUBWEVQV VSUBWEVQVU VSUBWEVVW VSUBWEVVWU " +
+		"VSUBWEVWH VSUBWEVWHU VSUBWODHB VSUBWODHBU VSUBWODQV VSUBWODQVU " +
+		"VSUBWODVW VSUBWODVWU VSUBWODWH VSUBWODWHU VSUBWU VXORB VXORV WORD XOR " +
+		"XVADDB XVADDBU XVADDD XVADDF XVADDH XVADDHU XVADDQ XVADDV XVADDVU " +
+		"XVADDW XVADDWEVHB XVADDWEVHBU XVADDWEVQV XVADDWEVQVU XVADDWEVVW " +
+		"XVADDWEVVWU XVADDWEVWH XVADDWEVWHU XVADDWODHB XVADDWODHBU XVADDWODQV " +
+		"XVADDWODQVU XVADDWODVW XVADDWODVWU XVADDWODWH XVADDWODWHU XVADDWU " +
+		"XVANDB XVANDNV XVANDV XVBITCLRB XVBITCLRH XVBITCLRV XVBITCLRW XVBITREVB " +
+		"XVBITREVH XVBITREVV XVBITREVW XVBITSETB XVBITSETH XVBITSETV XVBITSETW " +
+		"XVDIVB XVDIVBU XVDIVD XVDIVF XVDIVH XVDIVHU XVDIVV XVDIVVU XVDIVW " +
+		"XVDIVWU XVEXTRINSB XVEXTRINSH XVEXTRINSV XVEXTRINSW XVFCLASSD XVFCLASSF " +
+		"XVFRECIPD XVFRECIPF XVFRINTD XVFRINTF XVFRINTRMD XVFRINTRMF XVFRINTRNED " +
+		"XVFRINTRNEF XVFRINTRPD XVFRINTRPF XVFRINTRZD XVFRINTRZF XVFRSQRTD " +
+		"XVFRSQRTF XVFSQRTD XVFSQRTF XVILVHB XVILVHH XVILVHV XVILVHW XVILVLB " +
+		"XVILVLH XVILVLV XVILVLW XVMADDB XVMADDH XVMADDV XVMADDW XVMADDWEVHB " +
+		"XVMADDWEVHBU XVMADDWEVHBUB XVMADDWEVQV XVMADDWEVQVU XVMADDWEVQVUV " +
+		"XVMADDWEVVW XVMADDWEVVWU XVMADDWEVVWUW XVMADDWEVWH XVMADDWEVWHU " +
+		"XVMADDWEVWHUH XVMADDWODHB XVMADDWODHBU XVMADDWODHBUB XVMADDWODQV " +
+		"XVMADDWODQVU XVMADDWODQVUV XVMADDWODVW XVMADDWODVWU XVMADDWODVWUW " +
+		"XVMADDWODWH XVMADDWODWHU XVMADDWODWHUH XVMODB XVMODBU XVMODH XVMODHU " +
+		"XVMODV XVMODVU XVMODW XVMODWU XVMOVQ XVMSUBB XVMSUBH XVMSUBV XVMSUBW " +
+		"XVMUHB XVMUHBU XVMUHH XVMUHHU XVMUHV XVMUHVU XVMUHW XVMUHWU XVMULB " +
+		"XVMULD XVMULF XVMULH XVMULV XVMULW XVMULWEVHB XVMULWEVHBU XVMULWEVHBUB " +
+		"XVMULWEVQV XVMULWEVQVU XVMULWEVQVUV XVMULWEVVW XVMULWEVVWU XVMULWEVVWUW " +
+		"XVMULWEVWH XVMULWEVWHU XVMULWEVWHUH XVMULWODHB XVMULWODHBU XVMULWODHBUB " +
+		"XVMULWODQV XVMULWODQVU XVMULWODQVUV XVMULWODVW XVMULWODVWU XVMULWODVWUW " +
+		"XVMULWODWH XVMULWODWHU XVMULWODWHUH XVNEGB XVNEGH XVNEGV XVNEGW XVNORB " +
+		"XVNORV XVORB XVORNV XVORV XVPCNTB XVPCNTH XVPCNTV XVPCNTW XVPERMIQ " +
+		"XVPERMIV XVPERMIW XVROTRB XVROTRH XVROTRV XVROTRW XVSADDB XVSADDBU " +
+		"XVSADDH XVSADDHU XVSADDV XVSADDVU XVSADDW XVSADDWU XVSEQB XVSEQH XVSEQV " +
+		"XVSEQW XVSETALLNEB XVSETALLNEH XVSETALLNEV XVSETALLNEW XVSETANYEQB " +
+		"XVSETANYEQH XVSETANYEQV XVSETANYEQW XVSETEQV XVSETNEV XVSHUF4IB " +
+		"XVSHUF4IH XVSHUF4IV XVSHUF4IW XVSHUFB XVSHUFH XVSHUFV XVSHUFW XVSLLB " +
+		"XVSLLH XVSLLV XVSLLW XVSLTB XVSLTBU XVSLTH XVSLTHU XVSLTV XVSLTVU " +
+		"XVSLTW XVSLTWU XVSRAB XVSRAH XVSRAV XVSRAW XVSRLB XVSRLH XVSRLV XVSRLW " +
+		"XVSSUBB XVSSUBBU XVSSUBH XVSSUBHU XVSSUBV XVSSUBVU XVSSUBW XVSSUBWU " +
+		"XVSUBB XVSUBBU XVSUBD XVSUBF XVSUBH XVSUBHU XVSUBQ XVSUBV XVSUBVU " +
+		"XVSUBW XVSUBWEVHB XVSUBWEVHBU XVSUBWEVQV XVSUBWEVQVU XVSUBWEVVW " +
+		"XVSUBWEVVWU XVSUBWEVWH XVSUBWEVWHU XVSUBWODHB XVSUBWODHBU XVSUBWODQV " +
+		"XVSUBWODQVU XVSUBWODVW XVSUBWODVWU XVSUBWODWH XVSUBWODWHU XVSUBWU " +
+		"XVXORB XVXORV",
+	"mips": "ABSD ABSF ABSW ADD ADDD ADDF ADDU ADDV ADDVU ADDW AND BEQ BFPF BFPT " +
+		"BGEZ BGEZAL BGTZ BLEZ BLTZ BLTZAL BNE BREAK CALL CLO CLZ CMOVF CMOVN " +
+		"CMOVT CMOVZ CMPEQD CMPEQF CMPGED CMPGEF CMPGTD CMPGTF DATA DIV DIVD " +
+		"DIVF DIVU DIVV DIVVU DIVW DSBH DSHD DUFFCOPY DUFFZERO END FUNCDATA " +
+		"GETCALLERPC GLOBL GOK JAL JMP LL LLV LUI MADD MOVB MOVBU MOVD MOVDF " +
+		"MOVDV MOVDW MOVF MOVFD MOVFV MOVFW MOVH MOVHU MOVV MOVVD MOVVF MOVVL " +
+		"MOVVR MOVW MOVWD MOVWF MOVWL MOVWR MOVWU MSUB MUL MULD MULF MULU MULV " +
+		"MULVU MULW NEGD NEGF NEGV NEGW NOOP NOP NOR OR PCALIGN PCALIGNMAX " +
+		"PCDATA REM REMU REMV REMVU RET RFE ROTR ROTRV SC SCV SEB SEH SGT SGTU " +
+		"SLL SLLV SQRTD SQRTF SRA SRAV SRL SRLV SUB SUBD SUBF SUBU SUBV SUBVU " +
+		"SUBW SYNC SYSCALL TEQ TEXT TLBP TLBR TLBWI TLBWR TNE TRUNCDV TRUNCDW " +
+		"TRUNCFV TRUNCFW UNDEF VMOVB VMOVD VMOVH VMOVW WORD WSBH XOR",
+	"ppc64": "ADD ADDC ADDCC ADDCCC ADDCV ADDCVCC ADDE ADDECC ADDEV ADDEVCC ADDEX " +
+		"ADDIS ADDME ADDMECC ADDMEV ADDMEVCC ADDV ADDVCC ADDZE ADDZECC ADDZEV " +
+		"ADDZEVCC AND ANDCC ANDISCC ANDN ANDNCC BC BCL BDNZ BDZ BEQ BGE BGT BL " +
+		"BLE BLT BNE BR BRD BRH BRW BVC BVS CALL CFUGED CLRLSLDI CLRLSLWI CMP " +
+		"CMPB CMPEQB CMPU CMPW CMPWU CNTLZD CNTLZDCC CNTLZDM CNTLZW CNTLZWCC " +
+		"CNTTZD CNTTZDCC CNTTZDM CNTTZW CNTTZWCC COPY CRAND CRANDN CREQV CRNAND " +
+		"CRNOR CROR CRORN CRXOR DADD DADDQ DARN DATA DCBF DCBI DCBST DCBT DCBTST " +
+		"DCBZ DCFFIXQQ DCMPO DCMPOQ DCMPU DCMPUQ DCTFIXQQ DDIV DDIVQ DIVD DIVDCC " +
+		"DIVDE DIVDECC DIVDEU DIVDEUCC DIVDU DIVDUCC DIVDUV DIVDUVCC DIVDV " +
+		"DIVDVCC DIVW DIVWCC DIVWU DIVWUCC DIVWUV DIVWUVCC DIVWV DIVWVCC DMUL " +
+		"DMULQ DSUB DSUBQ DUFFCOPY DUFFZERO DWORD EIEIO END EQV EQVCC EXTSB " +
+		"EXTSBCC EXTSH EXTSHCC EXTSW EXTSWCC EXTSWSLI EXTSWSLICC FABS FABSCC " +
+		"FADD FADDCC FADDS FADDSCC FCFID FCFIDCC FCFIDS FCFIDSCC FCFIDU FCFIDUCC " +
+		"FCMPO FCMPU FCPSGN FCPSGNCC FCTID FCTIDCC FCTIDZ FCTIDZCC FCTIW FCTIWCC " +
+		"FCTIWZ FCTIWZCC FDIV FDIVCC FDIVS FDIVSCC FMADD FMADDCC FMADDS FMADDSCC " +
+		"FMOVD FMOVDCC FMOVDU FMOVS FMOVSU FMOVSX FMOVSZ FMSUB FMSUBCC FMSUBS " +
+		"FMSUBSCC FMUL FMULCC FMULS FMULSCC FNABS FNABSCC FNEG FNEGCC FNMADD " +
+		"FNMADDCC FNMADDS FNMADDSCC FNMSUB FNMSUBCC FNMSUBS FNMSUBSCC FRES " +
+		"FRESCC FRIM FRIMCC FRIN FRINCC FRIP FRIPCC FRIZ FRIZCC FRSP FRSPCC " +
+		"FRSQRTE FRSQRTECC FSEL FSELCC FSQRT FSQRTCC FSQRTS FSQRTSCC FSUB FSUBCC " +
+		"FSUBS FSUBSCC FTDIV FTSQRT FUNCDATA GETCALLERPC GLOBL HASHCHK HASHCHKP " +
+		"HASHST HASHSTP HRFID ICBI ISEL ISYNC JMP LASTAOUT LBAR LDAR LHAR LSW " +
+		"LVEBX LVEHX LVEWX LVSL LVSR LVX LVXL LWAR LWSYNC LXSDX LXSIWAX LXSIWZX " +
+		"LXV LXVB16X LXVD2X LXVDSX LXVH8X LXVKQ LXVL LXVLL LXVP LXVPX LXVRBX " +
+		"LXVRDX LXVRHX LXVRWX LXVW4X LXVX MADDHD MADDHDU MADDLD MFFPRD MFVRD " +
+		"MFVSRD MFVSRLD MFVSRWZ MODSD MODSW MODUD MODUW MOVB MOVBU MOVBZ MOVBZU " +
+		"MOVCRFS MOVD MOVDBR MOVDU MOVFL MOVH MOVHBR MOVHU MOVHZ MOVHZU MOVMW " +
+		"MOVW MOVWBR MOVWU MOVWZ MOVWZU MTFPRD MTFSB0 MTFSB0CC MTFSB1 MTFSB1CC " +
+		"MTVRD MTVSRBM MTVSRBMI MTVSRD MTVSRDD MTVSRDM MTVSRHM MTVSRQM MTVSRWA " +
+		"MTVSRWM MTVSRWS MTVSRWZ MULHD MULHDCC MULHDU MULHDUCC MULHW MULHWCC " +
+		"MULHWU MULHWUCC MULLD MULLDCC MULLDV MULLDVCC MULLW MULLWCC MULLWV " +
+		"MULLWVCC NAND NANDCC NEG NEGCC NEGV NEGVCC NOP NOR NORCC OR ORCC ORIS " +
+		"ORN ORNCC PADDI PASTECC PCALIGN PCALIGNMAX PCDATA PDEPD PEXTD PLBZ PLD " +
+		"PLFD PLFS PLHA PLHZ PLQ PLWA PLWZ PLXSD PLXSSP PLXV PLXVP PMXVBF16GER2 " +
+		"PMXVBF16GER2NN PMXVBF16GER2NP PMXVBF16GER2PN PMXVBF16GER2PP PMXVF16GER2 " +
+		"PMXVF16GER2NN PMXVF16GER2NP PMXVF16GER2PN PMXVF16GER2PP PMXVF32GER " +
+		"PMXVF32GERNN PMXVF32GERNP PMXVF32GERPN PMXVF32GERPP PMXVF64GER " +
+		"PMXVF64GERNN PMXVF64GERNP PMXVF64GERPN PMXVF64GERPP PMXVI16GER2 " +
+		"PMXVI16GER2PP PMXVI16GER2S PMXVI16GER2SPP PMXVI4GER8 PMXVI4GER8PP " +
+		"PMXVI8GER4 PMXVI8GER4PP PMXVI8GER4SPP PNOP POPCNTB POPCNTD POPCNTW PSTB " +
+		"PSTD PSTFD PSTFS PSTH PSTQ PSTW PSTXSD PSTXSSP PSTXV PSTXVP PTESYNC REM " +
+		"REMD REMDU REMU RET RFCI RFI RFID RLDC RLDCCC RLDCL RLDCLCC RLDCR " +
+		"RLDCRCC RLDIC RLDICCC RLDICL RLDICLCC RLDICR RLDICRCC RLDIMI RLDIMICC " +
+		"RLDMI RLDMICC RLWMI RLWMICC RLWNM RLWNMCC ROTL ROTLW SETB SETBC SETBCR " +
+		"SETNBC SETNBCR SLBIA SLBIE SLBMFEE SLBMFEV SLBMTE SLD SLDCC SLW SLWCC " +
+		"SRAD SRADCC SRAW SRAWCC SRD SRDCC SRW SRWCC STBCCC STDCCC STHCCC STSW " +
+		"STVEBX STVEHX STVEWX STVX STVXL STWCCC STXSDX STXSIWX STXV STXVB16X " +
+		"STXVD2X STXVH8X STXVL STXVLL STXVP STXVPX STXVRBX STXVRDX STXVRHX " +
+		"STXVRWX STXVW4X STXVX SUB SUBC SUBCC SUBCCC SUBCV SUBCVCC SUBE SUBECC " +
+		"SUBEV SUBEVCC SUBME SUBMECC SUBMEV SUBMEVCC SUBV SUBVCC SUBZE SUBZECC " +
+		"SUBZEV SUBZEVCC SYNC SYSCALL TD TEXT TLBIE TLBIEL TLBSYNC TW UNDEF " +
+		"VADDCU VADDCUQ VADDCUW VADDE VADDECUQ VADDEUQM VADDSBS VADDSHS VADDSS " +
+		"VADDSWS VADDUBM VADDUBS VADDUDM VADDUHM VADDUHS VADDUM VADDUQM VADDUS " +
+		"VADDUWM VADDUWS VAND VANDC VBPERMD VBPERMQ VCFUGED VCIPH VCIPHER " +
+		"VCIPHERLAST VCLRLB VCLRRB VCLZ VCLZB VCLZD VCLZDM VCLZH VCLZLSBB VCLZW " +
+		"VCMPEQ VCMPEQUB VCMPEQUBCC VCMPEQUD VCMPEQUDCC VCMPEQUH VCMPEQUHCC " +
+		"VCMPEQUQ VCMPEQUQCC VCMPEQUW VCMPEQUWCC VCMPGT VCMPGTSB VCMPGTSBCC " +
+		"VCMPGTSD VCMPGTSDCC VCMPGTSH VCMPGTSHCC VCMPGTSQ VCMPGTSQCC VCMPGTSW " +
+		"VCMPGTSWCC VCMPGTUB VCMPGTUBCC VCMPGTUD VCMPGTUDCC VCMPGTUH VCMPGTUHCC " +
+		"VCMPGTUQ VCMPGTUQCC VCMPGTUW VCMPGTUWCC VCMPNEB VCMPNEBCC VCMPNEH " +
+		"VCMPNEHCC VCMPNEW VCMPNEWCC VCMPNEZB VCMPNEZBCC VCMPSQ VCMPUQ VCNTMBB " +
+		"VCNTMBD VCNTMBH VCNTMBW VCTZDM VCTZLSBB VDIVESD VDIVESQ VDIVESW VDIVEUD " +
+		"VDIVEUQ VDIVEUW VDIVSD VDIVSQ VDIVSW VDIVUD VDIVUQ VDIVUW VEQV " +
+		"VEXPANDBM VEXPANDDM VEXPANDHM VEXPANDQM VEXPANDWM VEXTDDVLX VEXTDDVRX " +
+		"VEXTDUBVLX VEXTDUBVRX VEXTDUHVLX VEXTDUHVRX VEXTDUWVLX VEXTDUWVRX " +
+		"VEXTRACTBM VEXTRACTDM VEXTRACTHM VEXTRACTQM VEXTRACTWM VEXTSD2Q VGNB " +
+		"VINSBLX VINSBRX VINSBVLX VINSBVRX VINSD VINSDLX VINSDRX VINSHLX VINSHRX " +
+		"VINSHVLX VINSHVRX VINSW VINSWLX VINSWRX VINSWVLX VINSWVRX VMODSD VMODSQ " +
+		"VMODSW VMODUD VMODUQ VMODUW VMRGEW VMRGOW VMSUMCUD VMSUMUDM VMULESB " +
+		"VMULESD VMULESH VMULESW VMULEUB VMULEUD VMULEUH VMULEUW VMULHSD VMULHSW " +
+		"VMULHUD VMULHUW VMULLD VMULOSB VMULOSD VMULOSH VMULOSW VMULOUB VMULOUD " +
+		"VMULOUH VMULOUW VMULUWM VNAND VNCIPH VNCIPHER VNCIPHERLAST VNOR VOR " +
+		"VORC VPDEPD VPERM VPERMR VPERMXOR VPEXTD VPMSUM VPMSUMB VPMSUMD VPMSUMH " +
+		"VPMSUMW VPOPCNT VPOPCNTB VPOPCNTD VPOPCNTH VPOPCNTW VR VRLB VRLD VRLH " +
+		"VRLQ VRLQMI VRLQNM VRLW VS VSA VSBOX VSEL VSHASIGMA VSHASIGMAD " +
+		"VSHASIGMAW VSL VSLB VSLD VSLDBI VSLDOI VSLH VSLO VSLQ VSLW VSOI VSPLTB " +
+		"VSPLTH VSPLTISB VSPLTISH VSPLTISW VSPLTW VSR VSRAB VSRAD VSRAH VSRAQ " +
+		"VSRAW VSRB VSRD VSRDBI VSRH VSRO VSRQ VSRW VSTRIBL VSTRIBLCC VSTRIBR " +
+		"VSTRIBRCC VSTRIHL VSTRIHLCC VSTRIHR VSTRIHRCC VSUBCU VSUBCUQ VSUBCUW " +
+		"VSUBE VSUBECUQ VSUBEUQM VSUBSBS VSUBSHS VSUBSS VSUBSWS VSUBUBM VSUBUBS " +
+		"VSUBUDM VSUBUHM VSUBUHS VSUBUM VSUBUQM VSUBUS VSUBUWM VSUBUWS VXOR WORD " +
+		"XOR XORCC XORIS XSCMPEQQP XSCMPGEQP XSCMPGTQP XSCVDPSP XSCVDPSPN " +
+		"XSCVDPSXDS XSCVDPSXWS XSCVDPUXDS XSCVDPUXWS XSCVQPSQZ XSCVQPUQZ " +
+		"XSCVSPDP XSCVSPDPN XSCVSQQP XSCVSXDDP XSCVSXDSP XSCVUQQP XSCVUXDDP " +
+		"XSCVUXDSP XSMAXCQP XSMAXJDP XSMINCQP XSMINJDP XVBF16GER2 XVBF16GER2NN " +
+		"XVBF16GER2NP XVBF16GER2PN XVBF16GER2PP XVCVBF16SPN XVCVDPSP XVCVDPSXDS " +
+		"XVCVDPSXWS XVCVDPUXDS XVCVDPUXWS XVCVSPBF16 XVCVSPDP XVCVSPSXDS " +
+		"XVCVSPSXWS XVCVSPUXDS XVCVSPUXWS XVCVSXDDP XVCVSXDSP XVCVSXWDP " +
+		"XVCVSXWSP XVCVUXDDP XVCVUXDSP XVCVUXWDP XVCVUXWSP XVF16GER2 XVF16GER2NN " +
+		"XVF16GER2NP XVF16GER2PN XVF16GER2PP XVF32GER XVF32GERNN XVF32GERNP " +
+		"XVF32GERPN XVF32GERPP XVF64GER XVF64GERNN XVF64GERNP XVF64GERPN " +
+		"XVF64GERPP XVI16GER2 XVI16GER2PP XVI16GER2S XVI16GER2SPP XVI4GER8 " +
+		"XVI4GER8PP XVI8GER4 XVI8GER4PP XVI8GER4SPP XVTLSBB XXBLENDVB XXBLENDVD " +
+		"XXBLENDVH XXBLENDVW XXBRD XXBRH XXBRQ XXBRW XXEVAL XXGENPCVBM " +
+		"XXGENPCVDM XXGENPCVHM XXGENPCVWM XXLAND XXLANDC XXLEQV XXLNAND XXLNOR " +
+		"XXLOR XXLORC XXLORQ XXLXOR XXMFACC XXMRGHW XXMRGLW XXMTACC XXPERM " +
+		"XXPERMDI XXPERMX XXSEL XXSETACCZ XXSLDWI XXSPLTI32DX XXSPLTIB XXSPLTIDP " +
+		"XXSPLTIW XXSPLTW",
+	"riscv64": "ADD ADDI ADDIW ADDUW ADDW AMOADDD AMOADDW AMOANDD AMOANDW AMOMAXD " +
+		"AMOMAXUD AMOMAXUW AMOMAXW AMOMIND AMOMINUD AMOMINUW AMOMINW AMOORD " +
+		"AMOORW AMOSWAPD AMOSWAPW AMOXORD AMOXORW AND ANDI ANDN AUIPC BCLR BCLRI " +
+		"BEQ BEQZ BEXT BEXTI BGE BGEU BGEZ BGT BGTU BGTZ BINV BINVI BLE BLEU " +
+		"BLEZ BLT BLTU BLTZ BNE BNEZ BSET BSETI CADD CADDI CADDI16SP CADDI4SPN " +
+		"CADDIW CADDW CALL CAND CANDI CBEQZ CBNEZ CEBREAK CFLD CFLDSP CFSD " +
+		"CFSDSP CJ CJALR CJR CLD CLDSP CLI CLMUL CLMULH CLMULR CLUI CLW CLWSP " +
+		"CLZ CLZW CMV CNOP COR CPOP CPOPW CSD CSDSP CSLLI CSRAI CSRC CSRCI CSRLI " +
+		"CSRR CSRRC CSRRCI CSRRS CSRRSI CSRRW CSRRWI CSRS CSRSI CSRW CSRWI CSUB " +
+		"CSUBW CSW CSWSP CTZ CTZW CXOR CZEROEQZ CZERONEZ DATA DIV DIVU DIVUW " +
+		"DIVW DRET DUFFCOPY DUFFZERO EBREAK ECALL END FABSD FABSS FADDD FADDQ " +
+		"FADDS FCLASSD FCLASSQ FCLASSS FCVTDL FCVTDLU FCVTDQ FCVTDS FCVTDW " +
+		"FCVTDWU FCVTLD FCVTLQ FCVTLS FCVTLUD FCVTLUQ FCVTLUS FCVTQD FCVTQL " +
+		"FCVTQLU FCVTQS FCVTQW FCVTQWU FCVTSD FCVTSL FCVTSLU FCVTSQ FCVTSW " +
+		"FCVTSWU FCVTWD FCVTWQ FCVTWS FCVTWUD FCVTWUQ FCVTWUS FDIVD FDIVQ FDIVS " +
+		"FENCE FEQD FEQQ FEQS FLD FLED FLEQ FLES FLQ FLTD FLTQ FLTS FLW FMADDD " +
+		"FMADDQ FMADDS FMAXD FMAXQ FMAXS FMIND FMINQ FMINS FMSUBD FMSUBQ FMSUBS " +
+		"FMULD FMULQ FMULS FMVDX FMVSX FMVWX FMVXD FMVXS FMVXW FNED FNEGD FNEGS " +
+		"FNES FNMADDD FNMADDQ FNMADDS FNMSUBD FNMSUBQ FNMSUBS FSD FSGNJD FSGNJND " +
+		"FSGNJNQ FSGNJNS FSGNJQ FSGNJS FSGNJXD FSGNJXQ FSGNJXS FSQ FSQRTD FSQRTQ " +
+		"FSQRTS FSUBD FSUBQ FSUBS FSW FUNCDATA GETCALLERPC GLOBL JAL JALR JMP LB " +
+		"LBU LD LH LHU LRD LRW LUI LW LWU MAX MAXU MIN MINU MOV MOVB MOVBU MOVD " +
+		"MOVF MOVH MOVHU MOVW MOVWU MRET MUL MULH MULHSU MULHU MULW NEG NEGW NOP " +
+		"NOT OR ORCB ORI ORN PAUSE PCALIGN PCALIGNMAX PCDATA RDCYCLE RDINSTRET " +
+		"RDTIME REM REMU REMUW REMW RET REV8 ROL ROLW ROR RORI RORIW RORW SB " +
+		"SBREAK SCALL SCD SCW SD SEQZ SEXTB SEXTH SFENCEVMA SH SH1ADD SH1ADDUW " +
+		"SH2ADD SH2ADDUW SH3ADD SH3ADDUW SLL SLLI SLLIUW SLLIW SLLW SLT SLTI " +
+		"SLTIU SLTU SNEZ SRA SRAI SRAIW SRAW SRET SRL SRLI SRLIW SRLW SUB SUBW " +
+		"SW TEXT UNDEF VAADDUVV VAADDUVX VAADDVV VAADDVX VADCVIM VADCVVM VADCVXM " +
+		"VADDVI VADDVV VADDVX VANDNVV VANDNVX VANDVI VANDVV VANDVX VASUBUVV " +
+		"VASUBUVX VASUBVV VASUBVX VBREV8V VBREVV VCLMULHVV VCLMULHVX VCLMULVV " +
+		"VCLMULVX VCLZV VCOMPRESSVM VCPOPM VCPOPV VCTZV VDIVUVV VDIVUVX VDIVVV " +
+		"VDIVVX VFABSV VFADDVF VFADDVV VFCLASSV VFCVTFXUV VFCVTFXV VFCVTRTZXFV " +
+		"VFCVTRTZXUFV VFCVTXFV VFCVTXUFV VFDIVVF VFDIVVV VFIRSTM VFMACCVF " +
+		"VFMACCVV VFMADDVF VFMADDVV VFMAXVF VFMAXVV VFMERGEVFM VFMINVF VFMINVV " +
+		"VFMSACVF VFMSACVV VFMSUBVF VFMSUBVV VFMULVF VFMULVV VFMVFS VFMVSF " +
+		"VFMVVF VFNCVTFFW VFNCVTFXUW VFNCVTFXW VFNCVTRODFFW VFNCVTRTZXFW " +
+		"VFNCVTRTZXUFW VFNCVTXFW VFNCVTXUFW VFNEGV VFNMACCVF VFNMACCVV VFNMADDVF " +
+		"VFNMADDVV VFNMSACVF VFNMSACVV VFNMSUBVF VFNMSUBVV VFRDIVVF VFREC7V " +
+		"VFREDMAXVS VFREDMINVS VFREDOSUMVS VFREDUSUMVS VFRSQRT7V VFRSUBVF " +
+		"VFSGNJNVF VFSGNJNVV VFSGNJVF VFSGNJVV VFSGNJXVF VFSGNJXVV " +
+		"VFSLIDE1DOWNVF VFSLIDE1UPVF VFSQRTV VFSUBVF VFSUBVV VFWADDVF VFWADDVV " +
+		"VFWADDWF VFWADDWV VFWCVTFFV VFWCVTFXUV VFWCVTFXV VFWCVTRTZXFV " +
+		"VFWCVTRTZXUFV VFWCVTXFV VFWCVTXUFV VFWMACCVF VFWMACCVV VFWMSACVF " +
+		"VFWMSACVV VFWMULVF VFWMULVV VFWNMACCVF VFWNMACCVV VFWNMSACVF VFWNMSACVV " +
+		"VFWREDOSUMVS VFWREDUSUMVS VFWSUBVF VFWSUBVV VFWSUBWF VFWSUBWV VIDV " +
+		"VIOTAM VL1RE16V VL1RE32V VL1RE64V VL1RE8V VL1RV VL2RE16V VL2RE32V " +
+		"VL2RE64V VL2RE8V VL2RV VL4RE16V VL4RE32V VL4RE64V VL4RE8V VL4RV " +
+		"VL8RE16V VL8RE32V VL8RE64V VL8RE8V VL8RV VLE16FFV VLE16V VLE32FFV " +
+		"VLE32V VLE64FFV VLE64V VLE8FFV VLE8V VLMV VLOXEI16V VLOXEI32V VLOXEI64V " +
+		"VLOXEI8V VLOXSEG2EI16V VLOXSEG2EI32V VLOXSEG2EI64V VLOXSEG2EI8V " +
+		"VLOXSEG3EI16V VLOXSEG3EI32V VLOXSEG3EI64V VLOXSEG3EI8V VLOXSEG4EI16V " +
+		"VLOXSEG4EI32V VLOXSEG4EI64V VLOXSEG4EI8V VLOXSEG5EI16V VLOXSEG5EI32V " +
+		"VLOXSEG5EI64V VLOXSEG5EI8V VLOXSEG6EI16V VLOXSEG6EI32V VLOXSEG6EI64V " +
+		"VLOXSEG6EI8V VLOXSEG7EI16V VLOXSEG7EI32V VLOXSEG7EI64V VLOXSEG7EI8V " +
+		"VLOXSEG8EI16V VLOXSEG8EI32V VLOXSEG8EI64V VLOXSEG8EI8V VLSE16V VLSE32V " +
+		"VLSE64V VLSE8V VLSEG2E16FFV VLSEG2E16V VLSEG2E32FFV VLSEG2E32V " +
+		"VLSEG2E64FFV VLSEG2E64V VLSEG2E8FFV VLSEG2E8V VLSEG3E16FFV VLSEG3E16V " +
+		"VLSEG3E32FFV VLSEG3E32V VLSEG3E64FFV VLSEG3E64V VLSEG3E8FFV VLSEG3E8V " +
+		"VLSEG4E16FFV VLSEG4E16V VLSEG4E32FFV VLSEG4E32V VLSEG4E64FFV VLSEG4E64V " +
+		"VLSEG4E8FFV VLSEG4E8V VLSEG5E16FFV VLSEG5E16V VLSEG5E32FFV VLSEG5E32V " +
+		"VLSEG5E64FFV VLSEG5E64V VLSEG5E8FFV VLSEG5E8V VLSEG6E16FFV VLSEG6E16V " +
+		"VLSEG6E32FFV VLSEG6E32V VLSEG6E64FFV VLSEG6E64V VLSEG6E8FFV VLSEG6E8V " +
+		"VLSEG7E16FFV VLSEG7E16V VLSEG7E32FFV VLSEG7E32V VLSEG7E64FFV VLSEG7E64V " +
+		"VLSEG7E8FFV VLSEG7E8V VLSEG8E16FFV VLSEG8E16V VLSEG8E32FFV VLSEG8E32V " +
+		"VLSEG8E64FFV VLSEG8E64V VLSEG8E8FFV VLSEG8E8V VLSSEG2E16V VLSSEG2E32V " +
+		"VLSSEG2E64V VLSSEG2E8V VLSSEG3E16V VLSSEG3E32V VLSSEG3E64V VLSSEG3E8V " +
+		"VLSSEG4E16V VLSSEG4E32V VLSSEG4E64V VLSSEG4E8V VLSSEG5E16V VLSSEG5E32V " +
+		"VLSSEG5E64V VLSSEG5E8V VLSSEG6E16V VLSSEG6E32V VLSSEG6E64V VLSSEG6E8V " +
+		"VLSSEG7E16V VLSSEG7E32V VLSSEG7E64V VLSSEG7E8V VLSSEG8E16V VLSSEG8E32V " +
+		"VLSSEG8E64V VLSSEG8E8V VLUXEI16V VLUXEI32V VLUXEI64V VLUXEI8V " +
+		"VLUXSEG2EI16V VLUXSEG2EI32V VLUXSEG2EI64V VLUXSEG2EI8V VLUXSEG3EI16V " +
+		"VLUXSEG3EI32V VLUXSEG3EI64V VLUXSEG3EI8V VLUXSEG4EI16V VLUXSEG4EI32V " +
+		"VLUXSEG4EI64V VLUXSEG4EI8V VLUXSEG5EI16V VLUXSEG5EI32V VLUXSEG5EI64V " +
+		"VLUXSEG5EI8V VLUXSEG6EI16V VLUXSEG6EI32V VLUXSEG6EI64V VLUXSEG6EI8V " +
+		"VLUXSEG7EI16V VLUXSEG7EI32V VLUXSEG7EI64V VLUXSEG7EI8V VLUXSEG8EI16V " +
+		"VLUXSEG8EI32V VLUXSEG8EI64V VLUXSEG8EI8V VMACCVV VMACCVX VMADCVI " +
+		"VMADCVIM VMADCVV VMADCVVM VMADCVX VMADCVXM VMADDVV VMADDVX VMANDMM " +
+		"VMANDNMM VMAXUVV VMAXUVX VMAXVV VMAXVX VMCLRM VMERGEVIM VMERGEVVM " +
+		"VMERGEVXM VMFEQVF VMFEQVV VMFGEVF VMFGEVV VMFGTVF VMFGTVV VMFLEVF " +
+		"VMFLEVV VMFLTVF VMFLTVV VMFNEVF VMFNEVV VMINUVV VMINUVX VMINVV VMINVX " +
+		"VMMVM VMNANDMM VMNORMM VMNOTM VMORMM VMORNMM VMSBCVV VMSBCVVM VMSBCVX " +
+		"VMSBCVXM VMSBFM VMSEQVI VMSEQVV VMSEQVX VMSETM VMSGEUVI VMSGEUVV " +
+		"VMSGEVI VMSGEVV VMSGTUVI VMSGTUVV VMSGTUVX VMSGTVI VMSGTVV VMSGTVX " +
+		"VMSIFM VMSLEUVI VMSLEUVV VMSLEUVX VMSLEVI VMSLEVV VMSLEVX VMSLTUVI " +
+		"VMSLTUVV VMSLTUVX VMSLTVI VMSLTVV VMSLTVX VMSNEVI VMSNEVV VMSNEVX " +
+		"VMSOFM VMULHSUVV VMULHSUVX VMULHUVV VMULHUVX VMULHVV VMULHVX VMULVV " +
+		"VMULVX VMV1RV VMV2RV VMV4RV VMV8RV VMVSX VMVVI VMVVV VMVVX VMVXS " +
+		"VMXNORMM VMXORMM VNCLIPUWI VNCLIPUWV VNCLIPUWX VNCLIPWI VNCLIPWV " +
+		"VNCLIPWX VNCVTXXW VNEGV VNMSACVV VNMSACVX VNMSUBVV VNMSUBVX VNOTV " +
+		"VNSRAWI VNSRAWV VNSRAWX VNSRLWI VNSRLWV VNSRLWX VORVI VORVV VORVX " +
+		"VREDANDVS VREDMAXUVS VREDMAXVS VREDMINUVS VREDMINVS VREDORVS VREDSUMVS " +
+		"VREDXORVS VREMUVV VREMUVX VREMVV VREMVX VREV8V VRGATHEREI16VV " +
+		"VRGATHERVI VRGATHERVV VRGATHERVX VROLVV VROLVX VRORVI VRORVV VRORVX " +
+		"VRSUBVI VRSUBVX VS1RV VS2RV VS4RV VS8RV VSADDUVI VSADDUVV VSADDUVX " +
+		"VSADDVI VSADDVV VSADDVX VSBCVVM VSBCVXM VSE16V VSE32V VSE64V VSE8V " +
+		"VSETIVLI VSETVL VSETVLI VSEXTVF2 VSEXTVF4 VSEXTVF8 VSLIDE1DOWNVX " +
+		"VSLIDE1UPVX VSLIDEDOWNVI VSLIDEDOWNVX VSLIDEUPVI VSLIDEUPVX VSLLVI " +
+		"VSLLVV VSLLVX VSMULVV VSMULVX VSMV VSOXEI16V VSOXEI32V VSOXEI64V " +
+		"VSOXEI8V VSOXSEG2EI16V VSOXSEG2EI32V VSOXSEG2EI64V VSOXSEG2EI8V " +
+		"VSOXSEG3EI16V VSOXSEG3EI32V VSOXSEG3EI64V VSOXSEG3EI8V VSOXSEG4EI16V " +
+		"VSOXSEG4EI32V VSOXSEG4EI64V VSOXSEG4EI8V VSOXSEG5EI16V VSOXSEG5EI32V " +
+		"VSOXSEG5EI64V VSOXSEG5EI8V VSOXSEG6EI16V VSOXSEG6EI32V VSOXSEG6EI64V " +
+		"VSOXSEG6EI8V VSOXSEG7EI16V VSOXSEG7EI32V VSOXSEG7EI64V VSOXSEG7EI8V " +
+		"VSOXSEG8EI16V VSOXSEG8EI32V VSOXSEG8EI64V VSOXSEG8EI8V VSRAVI VSRAVV " +
+		"VSRAVX VSRLVI VSRLVV VSRLVX VSSE16V VSSE32V VSSE64V VSSE8V VSSEG2E16V " +
+		"VSSEG2E32V VSSEG2E64V VSSEG2E8V VSSEG3E16V VSSEG3E32V VSSEG3E64V " +
+		"VSSEG3E8V VSSEG4E16V VSSEG4E32V VSSEG4E64V VSSEG4E8V VSSEG5E16V " +
+		"VSSEG5E32V VSSEG5E64V VSSEG5E8V VSSEG6E16V VSSEG6E32V VSSEG6E64V " +
+		"VSSEG6E8V VSSEG7E16V VSSEG7E32V VSSEG7E64V VSSEG7E8V VSSEG8E16V " +
+		"VSSEG8E32V VSSEG8E64V VSSEG8E8V VSSRAVI VSSRAVV VSSRAVX VSSRLVI VSSRLVV " +
+		"VSSRLVX VSSSEG2E16V VSSSEG2E32V VSSSEG2E64V VSSSEG2E8V VSSSEG3E16V " +
+		"VSSSEG3E32V VSSSEG3E64V VSSSEG3E8V VSSSEG4E16V VSSSEG4E32V VSSSEG4E64V " +
+		"VSSSEG4E8V VSSSEG5E16V VSSSEG5E32V VSSSEG5E64V VSSSEG5E8V VSSSEG6E16V " +
+		"VSSSEG6E32V VSSSEG6E64V VSSSEG6E8V VSSSEG7E16V VSSSEG7E32V VSSSEG7E64V " +
+		"VSSSEG7E8V VSSSEG8E16V VSSSEG8E32V VSSSEG8E64V VSSSEG8E8V VSSUBUVV " +
+		"VSSUBUVX VSSUBVV VSSUBVX VSUBVV VSUBVX VSUXEI16V VSUXEI32V VSUXEI64V " +
+		"VSUXEI8V VSUXSEG2EI16V VSUXSEG2EI32V VSUXSEG2EI64V VSUXSEG2EI8V " +
+		"VSUXSEG3EI16V VSUXSEG3EI32V VSUXSEG3EI64V VSUXSEG3EI8V VSUXSEG4EI16V " +
+		"VSUXSEG4EI32V VSUXSEG4EI64V VSUXSEG4EI8V VSUXSEG5EI16V VSUXSEG5EI32V " +
+		"VSUXSEG5EI64V VSUXSEG5EI8V VSUXSEG6EI16V VSUXSEG6EI32V VSUXSEG6EI64V " +
+		"VSUXSEG6EI8V VSUXSEG7EI16V VSUXSEG7EI32V VSUXSEG7EI64V VSUXSEG7EI8V " +
+		"VSUXSEG8EI16V VSUXSEG8EI32V VSUXSEG8EI64V VSUXSEG8EI8V VWADDUVV " +
+		"VWADDUVX VWADDUWV VWADDUWX VWADDVV VWADDVX VWADDWV VWADDWX VWCVTUXXV " +
+		"VWCVTXXV VWMACCSUVV VWMACCSUVX VWMACCUSVX VWMACCUVV VWMACCUVX VWMACCVV " +
+		"VWMACCVX VWMULSUVV VWMULSUVX VWMULUVV VWMULUVX VWMULVV VWMULVX " +
+		"VWREDSUMUVS VWREDSUMVS VWSLLVI VWSLLVV VWSLLVX VWSUBUVV VWSUBUVX " +
+		"VWSUBUWV VWSUBUWX VWSUBVV VWSUBVX VWSUBWV VWSUBWX VXORVI VXORVV VXORVX " +
+		"VZEXTVF2 VZEXTVF4 VZEXTVF8 WFI WORD XNOR XOR XORI ZEXTH",
+	"s390x": "ADD ADDC ADDE ADDW AND ANDW BC BCL BEQ BGE BGT BL BLE BLEU BLT BLTU BNE " +
+		"BR BRC BRCT BRCTG BRRK BVC BVS BYTE CALL CDFBRA CDGBRA CDLFBR CDLGBR " +
+		"CEBR CEFBRA CEGBRA CELFBR CELGBR CFDBRA CFEBRA CGDBRA CGEBRA CGIJ CGRJ " +
+		"CIJ CLC CLEAR CLFDBR CLFEBR CLGDBR CLGEBR CLGIJ CLGRJ CLIJ CLRJ CMP " +
+		"CMPBEQ CMPBGE CMPBGT CMPBLE CMPBLT CMPBNE CMPU CMPUBEQ CMPUBGE CMPUBGT " +
+		"CMPUBLE CMPUBLT CMPUBNE CMPW CMPWU CPSDR CRJ CS CSG DATA DIVD DIVDU " +
+		"DIVW DIVWU DUFFCOPY DUFFZERO DWORD END EXRL FABS FADD FADDS FCMPO FCMPU " +
+		"FDIV FDIVS FIDBR FIEBR FLOGR FMADD FMADDS FMOVD FMOVS FMSUB FMSUBS FMUL " +
+		"FMULS FNABS FNEG FNEGS FSQRT FSQRTS FSUB FSUBS FUNCDATA GETCALLERPC " +
+		"GLOBL IPM JMP KDSA KIMD KLMD KM KMA KMC KMCTR LA LAA LAAG LAAL LAALG " +
+		"LAN LANG LAO LAOG LARL LAX LAXG LAY LCDBR LDEBR LDGR LEDBR LGDR LMG LMY " +
+		"LNDFR LOCGR LOCR LPDFR LTDBR LTEBR MLGR MODD MODDU MODW MODWU MOVB " +
+		"MOVBZ MOVD MOVDBR MOVDEQ MOVDGE MOVDGT MOVDLE MOVDLT MOVDNE MOVH MOVHBR " +
+		"MOVHZ MOVW MOVWBR MOVWZ MULHD MULHDU MULLD MULLW MVC MVCIN MVCLE NC NEG " +
+		"NEGW NOP NOPH OC OR ORW PCALIGN PCALIGNMAX PCDATA POPCNT RET RISBG " +
+		"RISBGN RISBGNZ RISBGZ RISBHG RISBHGZ RISBLG RISBLGZ RLL RLLG RNSBG " +
+		"RNSBGT ROSBG ROSBGT RXSBG RXSBGT SLD SLW SPM SRAD SRAW SRD SRW STCK " +
+		"STCKC STCKE STCKF STMG STMY SUB SUBC SUBE SUBV SUBW SYNC SYSCALL TCDB " +
+		"TCEB TEXT TMHH TMHL TMLH TMLL UNDEF VA VAB VAC VACC VACCB VACCC VACCCQ " +
+		"VACCF VACCG VACCH VACCQ VACQ VAF VAG VAH VAQ VAVG VAVGB VAVGF VAVGG " +
+		"VAVGH VAVGL VAVGLB VAVGLF VAVGLG VAVGLH VCDG VCDGB VCDLG VCDLGB VCEQ " +
+		"VCEQB VCEQBS VCEQF VCEQFS VCEQG VCEQGS VCEQH VCEQHS VCGD VCGDB VCH VCHB " +
+		"VCHBS VCHF VCHFS VCHG VCHGS VCHH VCHHS VCHL VCHLB VCHLBS VCHLF VCHLFS " +
+		"VCHLG VCHLGS VCHLH VCHLHS VCKSM VCLGD VCLGDB VCLZ VCLZB VCLZF VCLZG " +
+		"VCLZH VCTZ VCTZB VCTZF VCTZG VCTZH VEC VECB VECF VECG VECH VECL VECLB " +
+		"VECLF VECLG VECLH VERIM VERIMB VERIMF VERIMG VERIMH VERLL VERLLB VERLLF " +
+		"VERLLG VERLLH VERLLV VERLLVB VERLLVF VERLLVG VERLLVH VESL VESLB VESLF " +
+		"VESLG VESLH VESLV VESLVB VESLVF VESLVG VESLVH VESRA VESRAB VESRAF " +
+		"VESRAG VESRAH VESRAV VESRAVB VESRAVF VESRAVG VESRAVH VESRL VESRLB " +
+		"VESRLF VESRLG VESRLH VESRLV VESRLVB VESRLVF VESRLVG VESRLVH VFA VFADB " +
+		"VFAE VFAEB VFAEBS VFAEF VFAEFS VFAEH VFAEHS VFAEZB VFAEZBS VFAEZF " +
+		"VFAEZFS VFAEZH VFAEZHS VFCE VFCEDB VFCEDBS VFCH VFCHDB VFCHDBS VFCHE " +
+		"VFCHEDB VFCHEDBS VFD VFDDB VFEE VFEEB VFEEBS VFEEF VFEEFS VFEEH VFEEHS " +
+		"VFEEZB VFEEZBS VFEEZF VFEEZFS VFEEZH VFEEZHS VFENE VFENEB VFENEBS " +
+		"VFENEF VFENEFS VFENEH VFENEHS VFENEZB VFENEZBS VFENEZF VFENEZFS VFENEZH " +
+		"VFENEZHS VFI VFIDB VFLCDB VFLNDB VFLPDB VFM VFMA VFMADB VFMAXDB VFMAXSB " +
+		"VFMDB VFMINDB VFMINSB VFMS VFMSDB VFPSO VFPSODB VFS VFSDB VFSQ VFSQDB " +
+		"VFTCI VFTCIDB VGBM VGEF VGEG VGFM VGFMA VGFMAB VGFMAF VGFMAG VGFMAH " +
+		"VGFMB VGFMF VGFMG VGFMH VGM VGMB VGMF VGMG VGMH VISTR VISTRB VISTRBS " +
+		"VISTRF VISTRFS VISTRH VISTRHS VL VLBB VLC VLCB VLCF VLCG VLCH VLDE " +
+		"VLDEB VLEB VLED VLEDB VLEF VLEG VLEH VLEIB VLEIF VLEIG VLEIH VLGV VLGVB " +
+		"VLGVF VLGVG VLGVH VLL VLLEZ VLLEZB VLLEZF VLLEZG VLLEZH VLM VLP VLPB " +
+		"VLPF VLPG VLPH VLR VLREP VLREPB VLREPF VLREPG VLREPH VLVG VLVGB VLVGF " +
+		"VLVGG VLVGH VLVGP VMAE VMAEB VMAEF VMAEH VMAH VMAHB VMAHF VMAHH VMAL " +
+		"VMALB VMALE VMALEB VMALEF VMALEH VMALF VMALH VMALHB VMALHF VMALHH " +
+		"VMALHW VMALO VMALOB VMALOF VMALOH VMAO VMAOB VMAOF VMAOH VME VMEB VMEF " +
+		"VMEH VMH VMHB VMHF VMHH VML VMLB VMLE VMLEB VMLEF VMLEH VMLF VMLH VMLHB " +
+		"VMLHF VMLHH VMLHW VMLO VMLOB VMLOF VMLOH VMN VMNB VMNF VMNG VMNH VMNL " +
+		"VMNLB VMNLF VMNLG VMNLH VMO VMOB VMOF VMOH VMRH VMRHB VMRHF VMRHG VMRHH " +
+		"VMRL VMRLB VMRLF VMRLG VMRLH VMSLEG VMSLEOG VMSLG VMSLOG VMX VMXB VMXF " +
+		"VMXG VMXH VMXL VMXLB VMXLF VMXLG VMXLH VN VNC VNO VNOT VO VONE VPDI " +
+		"VPERM VPK VPKF VPKG VPKH VPKLS VPKLSF VPKLSFS VPKLSG VPKLSGS VPKLSH " +
+		"VPKLSHS VPKS VPKSF VPKSFS VPKSG VPKSGS VPKSH VPKSHS VPOPCT VREP VREPB " +
+		"VREPF VREPG VREPH VREPI VREPIB VREPIF VREPIG VREPIH VS VSB VSBCBI " +
+		"VSBCBIQ VSBI VSBIQ VSCBI VSCBIB VSCBIF VSCBIG VSCBIH VSCBIQ VSCEF VSCEG " +
+		"VSEG VSEGB VSEGF VSEGH VSEL VSF VSG VSH VSL VSLB VSLDB VSQ VSRA VSRAB " +
+		"VSRL VSRLB VST VSTEB VSTEF VSTEG VSTEH VSTL VSTM VSTRC VSTRCB VSTRCBS " +
+		"VSTRCF VSTRCFS VSTRCH VSTRCHS VSTRCZB VSTRCZBS VSTRCZF VSTRCZFS VSTRCZH " +
+		"VSTRCZHS VSTRL VSUM VSUMB VSUMG VSUMGF VSUMGH VSUMH VSUMQ VSUMQF VSUMQG " +
+		"VTM VUPH VUPHB VUPHF VUPHH VUPL VUPLB VUPLF VUPLH VUPLHB VUPLHF VUPLHH " +
+		"VUPLHW VUPLL VUPLLB VUPLLF VUPLLH VX VZERO WCDGB WCDLGB WCGDB WCLGDB " +
+		"WFADB WFC WFCDB WFCEDB WFCEDBS WFCHDB WFCHDBS WFCHEDB WFCHEDBS WFDDB " +
+		"WFIDB WFK WFKDB WFLCDB WFLNDB WFLPDB WFMADB WFMAXDB WFMAXSB WFMDB " +
+		"WFMINDB WFMINSB WFMSDB WFPSODB WFSDB WFSQDB WFTCIDB WLDEB WLEDB WORD XC " +
+		"XOR XORW",
+	"wasm": "Block Br BrIf BrTable CALL CALLNORESUME Call CallIndirect CurrentMemory " +
+		"DATA DUFFCOPY DUFFZERO DataDrop Drop END ElemDrop Else End F32Abs " +
+		"F32Add F32Ceil F32Const F32ConvertI32S F32ConvertI32U F32ConvertI64S " +
+		"F32ConvertI64U F32Copysign F32DemoteF64 F32Div F32Eq F32Floor F32Ge " +
+		"F32Gt F32Le F32Load F32Lt F32Max F32Min F32Mul F32Ne F32Nearest F32Neg " +
+		"F32ReinterpretI32 F32Sqrt F32Store F32Sub F32Trunc F32x4Abs F32x4Add " +
+		"F32x4Ceil F32x4ConvertI32x4S F32x4ConvertI32x4U F32x4DemoteF64x2Zero " +
+		"F32x4Div F32x4Eq F32x4ExtractLane F32x4Floor F32x4Ge F32x4Gt F32x4Le " +
+		"F32x4Lt F32x4Max F32x4Min F32x4Mul F32x4Ne F32x4Nearest F32x4Neg " +
+		"F32x4Pmax F32x4Pmin F32x4RelaxedMadd F32x4RelaxedMax F32x4RelaxedMin " +
+		"F32x4RelaxedNmadd F32x4ReplaceLane F32x4Splat F32x4Sqrt F32x4Sub " +
+		"F32x4Trunc F64Abs F64Add F64Ceil F64Const F64ConvertI32S F64ConvertI32U " +
+		"F64ConvertI64S F64ConvertI64U F64Copysign F64Div F64Eq F64Floor F64Ge " +
+		"F64Gt F64Le F64Load F64Lt F64Max F64Min F64Mul F64Ne F64Nearest F64Neg " +
+		"F64PromoteF32 F64ReinterpretI64 F64Sqrt F64Store F64Sub F64Trunc " +
+		"F64x2Abs F64x2Add F64x2Ceil F64x2ConvertLowI32x4S F64x2ConvertLowI32x4U " +
+		"F64x2Div F64x2Eq F64x2ExtractLane F64x2Floor F64x2Ge F64x2Gt F64x2Le " +
+		"F64x2Lt F64x2Max F64x2Min F64x2Mul F64x2Ne F64x2Nearest F64x2Neg " +
+		"F64x2Pmax F64x2Pmin F64x2PromoteLowF32x4 F64x2RelaxedMadd " +
+		"F64x2RelaxedMax F64x2RelaxedMin F64x2RelaxedNmadd F64x2ReplaceLane " +
+		"F64x2Splat F64x2Sqrt F64x2Sub F64x2Trunc FUNCDATA GETCALLERPC GLOBL Get " +
+		"GlobalGet GlobalSet GrowMemory I16x8Abs I16x8Add I16x8AddSatS " +
+		"I16x8AddSatU I16x8AllTrue I16x8AvgrU I16x8Bitmask I16x8Eq " +
+		"I16x8ExtaddPairwiseI8x16S I16x8ExtaddPairwiseI8x16U " +
+		"I16x8ExtendHighI8x16S I16x8ExtendHighI8x16U I16x8ExtendLowI8x16S " +
+		"I16x8ExtendLowI8x16U I16x8ExtmulHighI8x16S I16x8ExtmulHighI8x16U " +
+		"I16x8ExtmulLowI8x16S I16x8ExtmulLowI8x16U I16x8ExtractLaneS " +
+		"I16x8ExtractLaneU I16x8GeS I16x8GeU I16x8GtS I16x8GtU I16x8LeS I16x8LeU " +
+		"I16x8LtS I16x8LtU I16x8MaxS I16x8MaxU I16x8MinS I16x8MinU I16x8Mul " +
+		"I16x8NarrowI32x4S I16x8NarrowI32x4U I16x8Ne I16x8Neg I16x8Q15MulrSatS " +
+		"I16x8RelaxedDotI8x16I7x16S I16x8RelaxedLaneselect I16x8RelaxedQ15MulrS " +
+		"I16x8ReplaceLane I16x8Shl I16x8ShrS I16x8ShrU I16x8Splat I16x8Sub " +
+		"I16x8SubSatS I16x8SubSatU I32Add I32And I32Clz I32Const I32Ctz I32DivS " +
+		"I32DivU I32Eq I32Eqz I32Extend16S I32Extend8S I32GeS I32GeU I32GtS " +
+		"I32GtU I32LeS I32LeU I32Load I32Load16S I32Load16U I32Load8S I32Load8U " +
+		"I32LtS I32LtU I32Mul I32Ne I32Or I32Popcnt I32ReinterpretF32 I32RemS " +
+		"I32RemU I32Rotl I32Rotr I32Shl I32ShrS I32ShrU I32Store I32Store16 " +
+		"I32Store8 I32Sub I32TruncF32S I32TruncF32U I32TruncF64S I32TruncF64U " +
+		"I32TruncSatF32S I32TruncSatF32U I32TruncSatF64S I32TruncSatF64U " +
+		"I32WrapI64 I32Xor I32x4Abs I32x4Add I32x4AllTrue I32x4Bitmask " +
+		"I32x4DotI16x8S I32x4Eq I32x4ExtaddPairwiseI16x8S " +
+		"I32x4ExtaddPairwiseI16x8U I32x4ExtendHighI16x8S I32x4ExtendHighI16x8U " +
+		"I32x4ExtendLowI16x8S I32x4ExtendLowI16x8U I32x4ExtmulHighI16x8S " +
+		"I32x4ExtmulHighI16x8U I32x4ExtmulLowI16x8S I32x4ExtmulLowI16x8U " +
+		"I32x4ExtractLane I32x4GeS I32x4GeU I32x4GtS I32x4GtU I32x4LeS I32x4LeU " +
+		"I32x4LtS I32x4LtU I32x4MaxS I32x4MaxU I32x4MinS I32x4MinU I32x4Mul " +
+		"I32x4Ne I32x4Neg I32x4RelaxedDotI8x16I7x16AddS I32x4RelaxedLaneselect " +
+		"I32x4RelaxedTruncF32x4S I32x4RelaxedTruncF32x4U I32x4RelaxedTruncF64x2S " +
+		"I32x4RelaxedTruncF64x2U I32x4ReplaceLane I32x4Shl I32x4ShrS I32x4ShrU " +
+		"I32x4Splat I32x4Sub I32x4TruncSatF32x4S I32x4TruncSatF32x4U " +
+		"I32x4TruncSatF64x2SZero I32x4TruncSatF64x2UZero I64Add I64And I64Clz " +
+		"I64Const I64Ctz I64DivS I64DivU I64Eq I64Eqz I64Extend16S I64Extend32S " +
+		"I64Extend8S I64ExtendI32S I64ExtendI32U I64GeS I64GeU I64GtS I64GtU " +
+		"I64LeS I64LeU I64Load I64Load16S I64Load16U I64Load32S I64Load32U " +
+		"I64Load8S I64Load8U I64LtS I64LtU I64Mul I64Ne I64Or I64Popcnt " +
+		"I64ReinterpretF64 I64RemS I64RemU I64Rotl I64Rotr I64Shl I64ShrS " +
+		"I64ShrU I64Store I64Store16 I64Store32 I64Store8 I64Sub I64TruncF32S " +
+		"I64TruncF32U I64TruncF64S I64TruncF64U I64TruncSatF32S I64TruncSatF32U " +
+		"I64TruncSatF64S I64TruncSatF64U I64Xor I64x2Abs I64x2Add I64x2AllTrue " +
+		"I64x2Bitmask I64x2Eq I64x2ExtendHighI32x4S I64x2ExtendHighI32x4U " +
+		"I64x2ExtendLowI32x4S I64x2ExtendLowI32x4U I64x2ExtmulHighI32x4S " +
+		"I64x2ExtmulHighI32x4U I64x2ExtmulLowI32x4S I64x2ExtmulLowI32x4U " +
+		"I64x2ExtractLane I64x2GeS I64x2GtS I64x2LeS I64x2LtS I64x2Mul I64x2Ne " +
+		"I64x2Neg I64x2RelaxedLaneselect I64x2ReplaceLane I64x2Shl I64x2ShrS " +
+		"I64x2ShrU I64x2Splat I64x2Sub I8x16Abs I8x16Add I8x16AddSatS " +
+		"I8x16AddSatU I8x16AllTrue I8x16AvgrU I8x16Bitmask I8x16Eq " +
+		"I8x16ExtractLaneS I8x16ExtractLaneU I8x16GeS I8x16GeU I8x16GtS I8x16GtU " +
+		"I8x16LeS I8x16LeU I8x16LtS I8x16LtU I8x16MaxS I8x16MaxU I8x16MinS " +
+		"I8x16MinU I8x16NarrowI16x8S I8x16NarrowI16x8U I8x16Ne I8x16Neg " +
+		"I8x16Popcnt I8x16RelaxedLaneselect I8x16RelaxedSwizzle I8x16ReplaceLane " +
+		"I8x16Shl I8x16ShrS I8x16ShrU I8x16Shuffle16 I8x16Splat I8x16Sub " +
+		"I8x16SubSatS I8x16SubSatU I8x16Swizzle If JMP Last LocalGet LocalSet " +
+		"LocalTee Loop MOVB MOVD MOVH MOVW MemoryCopy MemoryFill MemoryInit NOP " +
+		"Nop Not PCALIGN PCALIGNMAX PCDATA RESUMEPOINT RET RETUNWIND " +
+		"ReservedFD9A01 ReservedFDA201 ReservedFDA501 ReservedFDA601 " +
+		"ReservedFDAF01 ReservedFDB001 ReservedFDB201 ReservedFDB301 " +
+		"ReservedFDB401 ReservedFDBB01 ReservedFDC201 ReservedFDC501 " +
+		"ReservedFDC601 ReservedFDCF01 ReservedFDD001 ReservedFDD201 " +
+		"ReservedFDD301 ReservedFDD401 ReservedFDE201 ReservedFDEE01 Return " +
+		"Select Set TEXT TableCopy TableFill TableGrow TableInit TableSize Tee " +
+		"UNDEF Unreachable V128And V128Andnot V128AnyTrue V128Bitselect " +
+		"V128Const V128Load V128Load16Lane V128Load16Splat V128Load16x4S " +
+		"V128Load16x4U V128Load32Lane V128Load32Splat V128Load32Zero " +
+		"V128Load32x2S V128Load32x2U V128Load64Lane V128Load64Splat " +
+		"V128Load64Zero V128Load8Lane V128Load8Splat V128Load8x8S V128Load8x8U " +
+		"V128Not V128Or V128Store V128Store16Lane V128Store32Lane " +
+		"V128Store64Lane V128Store8Lane V128Xor WORD",
+	"x86": "AAA AAD AAM AAS ADCB ADCL ADCQ ADCW ADCXL ADCXQ ADDB ADDL ADDPD ADDPS " +
+		"ADDQ ADDSD ADDSS ADDSUBPD ADDSUBPS ADDW ADJSP ADOXL ADOXQ AESDEC " +
+		"AESDECLAST AESENC AESENCLAST AESIMC AESKEYGENASSIST ANDB ANDL ANDNL " +
+		"ANDNPD ANDNPS ANDNQ ANDPD ANDPS ANDQ ANDW ARPL BEXTRL BEXTRQ BLENDPD " +
+		"BLENDPS BLENDVPD BLENDVPS BLSIL BLSIQ BLSMSKL BLSMSKQ BLSRL BLSRQ " +
+		"BOUNDL BOUNDW BSFL BSFQ BSFW BSRL BSRQ BSRW BSWAPL BSWAPQ BTCL BTCQ " +
+		"BTCW BTL BTQ BTRL BTRQ BTRW BTSL BTSQ BTSW BTW BYTE BZHIL BZHIQ CALL " +
+		"CBW CDQ CDQE CLAC CLC CLD CLDEMOTE CLFLUSH CLFLUSHOPT CLI CLTS CLWB CMC " +
+		"CMOVLCC CMOVLCS CMOVLEQ CMOVLGE CMOVLGT CMOVLHI CMOVLLE CMOVLLS CMOVLLT " +
+		"CMOVLMI CMOVLNE CMOVLOC CMOVLOS CMOVLPC CMOVLPL CMOVLPS CMOVQCC CMOVQCS " +
+		"CMOVQEQ CMOVQGE CMOVQGT CMOVQHI CMOVQLE CMOVQLS CMOVQLT CMOVQMI CMOVQNE " +
+		"CMOVQOC CMOVQOS CMOVQPC CMOVQPL CMOVQPS CMOVWCC CMOVWCS CMOVWEQ CMOVWGE " +
+		"CMOVWGT CMOVWHI CMOVWLE CMOVWLS CMOVWLT CMOVWMI CMOVWNE CMOVWOC CMOVWOS " +
+		"CMOVWPC CMOVWPL CMOVWPS CMPB CMPL CMPPD CMPPS CMPQ CMPSB CMPSD CMPSL " +
+		"CMPSQ CMPSS CMPSW CMPW CMPXCHG16B CMPXCHG8B CMPXCHGB CMPXCHGL CMPXCHGQ " +
+		"CMPXCHGW COMISD COMISS CPUID CQO CRC32B CRC32L CRC32Q CRC32W CVTPD2PL " +
+		"CVTPD2PS CVTPL2PD CVTPL2PS CVTPS2PD CVTPS2PL CVTSD2SL CVTSD2SQ CVTSD2SS " +
+		"CVTSL2SD CVTSL2SS CVTSQ2SD CVTSQ2SS CVTSS2SD CVTSS2SL CVTSS2SQ " +
+		"CVTTPD2PL CVTTPS2PL CVTTSD2SL CVTTSD2SQ CVTTSS2SL CVTTSS2SQ CWD CWDE " +
+		"DAA DAS DATA DECB DECL DECQ DECW DIVB DIVL DIVPD DIVPS DIVQ DIVSD DIVSS " +
+		"DIVW DPPD DPPS DUFFCOPY DUFFZERO EMMS END ENDBR64 ENTER EXTRACTPS F2XM1 " +
+		"FABS FADDD FADDDP FADDF FADDL FADDW FBLD FBSTP FCHS FCLEX FCMOVB " +
+		"FCMOVBE FCMOVCC FCMOVCS FCMOVE FCMOVEQ FCMOVHI FCMOVLS FCMOVNB FCMOVNBE " +
+		"FCMOVNE FCMOVNU FCMOVU FCMOVUN FCOMD FCOMDP FCOMDPP FCOMF FCOMFP FCOMI " +
+		"FCOMIP FCOML FCOMLP FCOMW FCOMWP FCOS FDECSTP FDIVD FDIVDP FDIVF FDIVL " +
+		"FDIVRD FDIVRDP FDIVRF FDIVRL FDIVRW FDIVW FFREE FINCSTP FINIT FLD1 " +
+		"FLDCW FLDENV FLDL2E FLDL2T FLDLG2 FLDLN2 FLDPI FLDZ FMOVB FMOVBP FMOVD " +
+		"FMOVDP FMOVF FMOVFP FMOVL FMOVLP FMOVV FMOVVP FMOVW FMOVWP FMOVX FMOVXP " +
+		"FMULD FMULDP FMULF FMULL FMULW FNOP FPATAN FPREM FPREM1 FPTAN FRNDINT " +
+		"FRSTOR FSAVE FSCALE FSIN FSINCOS FSQRT FSTCW FSTENV FSTSW FSUBD FSUBDP " +
+		"FSUBF FSUBL FSUBRD FSUBRDP FSUBRF FSUBRL FSUBRW FSUBW FTST FUCOM FUCOMI " +
+		"FUCOMIP FUCOMP FUCOMPP FUNCDATA FXAM FXCHD FXRSTOR FXRSTOR64 FXSAVE " +
+		"FXSAVE64 FXTRACT FYL2X FYL2XP1 GETCALLERPC GLOBL HADDPD HADDPS HLT " +
+		"HSUBPD HSUBPS ICEBP IDIVB IDIVL IDIVQ IDIVW IMUL3L IMUL3Q IMUL3W IMULB " +
+		"IMULL IMULQ IMULW INB INCB INCL INCQ INCW INL INSB INSERTPS INSL INSW " +
+		"INT INTO INVD INVLPG INVPCID INW IRETL IRETQ IRETW JA JAE JB JBE JC JCC " +
+		"JCS JCXZL JCXZQ JCXZW JE JEQ JG JGE JGT JHI JHS JL JLE JLO JLS JLT JMI " +
+		"JMP JNA JNAE JNB JNBE JNC JNE JNG JNGE JNL JNLE JNO JNP JNS JNZ JO JOC " +
+		"JOS JP JPC JPE JPL JPO JPS JS JZ KADDB KADDD KADDQ KADDW KANDB KANDD " +
+		"KANDNB KANDND KANDNQ KANDNW KANDQ KANDW KMOVB KMOVD KMOVQ KMOVW KNOTB " +
+		"KNOTD KNOTQ KNOTW KORB KORD KORQ KORTESTB KORTESTD KORTESTQ KORTESTW " +
+		"KORW KSHIFTLB KSHIFTLD KSHIFTLQ KSHIFTLW KSHIFTRB KSHIFTRD KSHIFTRQ " +
+		"KSHIFTRW KTESTB KTESTD KTESTQ KTESTW KUNPCKBW KUNPCKDQ KUNPCKWD KXNORB " +
+		"KXNORD KXNORQ KXNORW KXORB KXORD KXORQ KXORW LAHF LARL LARQ LARW LDDQU " +
+		"LDMXCSR LEAL LEAQ LEAVEL LEAVEQ LEAVEW LEAW LFENCE LFSL LFSQ LFSW LGDT " +
+		"LGSL LGSQ LGSW LIDT LLDT LMSW LOCK LODSB LODSL LODSQ LODSW LONG LOOP " +
+		"LOOPEQ LOOPNE LSLL LSLQ LSLW LSSL LSSQ LSSW LTR LZCNTL LZCNTQ LZCNTW " +
+		"MASKMOVDQU MASKMOVOU MASKMOVQ MAXPD MAXPS MAXSD MAXSS MFENCE MINPD " +
+		"MINPS MINSD MINSS MONITOR MOVAPD MOVAPS MOVB MOVBEL MOVBELL MOVBEQ " +
+		"MOVBEQQ MOVBEW MOVBEWW MOVBLSX MOVBLZX MOVBQSX MOVBQZX MOVBWSX MOVBWZX " +
+		"MOVD MOVDDUP MOVDQ2Q MOVHLPS MOVHPD MOVHPS MOVL MOVLHPS MOVLPD MOVLPS " +
+		"MOVLQSX MOVLQZX MOVMSKPD MOVMSKPS MOVNTDQ MOVNTDQA MOVNTIL MOVNTIQ " +
+		"MOVNTO MOVNTPD MOVNTPS MOVNTQ MOVO MOVOA MOVOU MOVQ MOVQL MOVQOZX MOVSB " +
+		"MOVSD MOVSHDUP MOVSL MOVSLDUP MOVSQ MOVSS MOVSW MOVSWW MOVUPD MOVUPS " +
+		"MOVW MOVWLSX MOVWLZX MOVWQSX MOVWQZX MOVZWW MPSADBW MULB MULL MULPD " +
+		"MULPS MULQ MULSD MULSS MULW MULXL MULXQ MWAIT NEGB NEGL NEGQ NEGW NOP " +
+		"NOPL NOPW NOTB NOTL NOTQ NOTW ORB ORL ORPD ORPS ORQ ORW OUTB OUTL OUTSB " +
+		"OUTSL OUTSW OUTW PABSB PABSD PABSW PACKSSLW PACKSSWB PACKUSDW PACKUSWB " +
+		"PADDB PADDD PADDL PADDQ PADDSB PADDSW PADDUSB PADDUSW PADDW PALIGNR " +
+		"PAND PANDN PAUSE PAVGB PAVGW PBLENDVB PBLENDW PCALIGN PCALIGNMAX PCDATA " +
+		"PCLMULQDQ PCMPEQB PCMPEQL PCMPEQQ PCMPEQW PCMPESTRI PCMPESTRM PCMPGTB " +
+		"PCMPGTL PCMPGTQ PCMPGTW PCMPISTRI PCMPISTRM PDEPL PDEPQ PEXTL PEXTQ " +
+		"PEXTRB PEXTRD PEXTRQ PEXTRW PHADDD PHADDSW PHADDW PHMINPOSUW PHSUBD " +
+		"PHSUBSW PHSUBW PINSRB PINSRD PINSRQ PINSRW PMADDUBSW PMADDWL PMAXSB " +
+		"PMAXSD PMAXSW PMAXUB PMAXUD PMAXUW PMINSB PMINSD PMINSW PMINUB PMINUD " +
+		"PMINUW PMOVMSKB PMOVSXBD PMOVSXBQ PMOVSXBW PMOVSXDQ PMOVSXWD PMOVSXWQ " +
+		"PMOVZXBD PMOVZXBQ PMOVZXBW PMOVZXDQ PMOVZXWD PMOVZXWQ PMULDQ PMULHRSW " +
+		"PMULHUW PMULHW PMULLD PMULLW PMULULQ POPAL POPAW POPCNTL POPCNTQ " +
+		"POPCNTW POPFL POPFQ POPFW POPL POPQ POPW POR PREFETCHNTA PREFETCHT0 " +
+		"PREFETCHT1 PREFETCHT2 PSADBW PSHUFB PSHUFD PSHUFHW PSHUFL PSHUFLW " +
+		"PSHUFW PSIGNB PSIGND PSIGNW PSLLDQ PSLLL PSLLO PSLLQ PSLLW PSRAL PSRAW " +
+		"PSRLDQ PSRLL PSRLO PSRLQ PSRLW PSUBB PSUBL PSUBQ PSUBSB PSUBSW PSUBUSB " +
+		"PSUBUSW PSUBW PTEST PUNPCKHBW PUNPCKHLQ PUNPCKHQDQ PUNPCKHWL PUNPCKLBW " +
+		"PUNPCKLLQ PUNPCKLQDQ PUNPCKLWL PUSHAL PUSHAW PUSHFL PUSHFQ PUSHFW PUSHL " +
+		"PUSHQ PUSHW PXOR QUAD RCLB RCLL RCLQ RCLW RCPPS RCPSS RCRB RCRL RCRQ " +
+		"RCRW RDFSBASEL RDFSBASEQ RDGSBASEL RDGSBASEQ RDMSR RDPID RDPKRU RDPMC " +
+		"RDRANDL RDRANDQ RDRANDW RDSEEDL RDSEEDQ RDSEEDW RDTSC RDTSCP REP REPN " +
+		"RET RETFL RETFQ RETFW ROLB ROLL ROLQ ROLW RORB RORL RORQ RORW RORXL " +
+		"RORXQ ROUNDPD ROUNDPS ROUNDSD ROUNDSS RSM RSQRTPS RSQRTSS SAHF SALB " +
+		"SALL SALQ SALW SARB SARL SARQ SARW SARXL SARXQ SBBB SBBL SBBQ SBBW " +
+		"SCASB SCASL SCASQ SCASW SETCC SETCS SETEQ SETGE SETGT SETHI SETLE SETLS " +
+		"SETLT SETMI SETNE SETOC SETOS SETPC SETPL SETPS SFENCE SGDT SHA1MSG1 " +
+		"SHA1MSG2 SHA1NEXTE SHA1RNDS4 SHA256MSG1 SHA256MSG2 SHA256RNDS2 SHLB " +
+		"SHLL SHLQ SHLW SHLXL SHLXQ SHRB SHRL SHRQ SHRW SHRXL SHRXQ SHUFPD " +
+		"SHUFPS SIDT SLDTL SLDTQ SLDTW SMSWL SMSWQ SMSWW SQRTPD SQRTPS SQRTSD " +
+		"SQRTSS STAC STC STD STI STMXCSR STOSB STOSL STOSQ STOSW STRL STRQ STRW " +
+		"SUBB SUBL SUBPD SUBPS SUBQ SUBSD SUBSS SUBW SWAPGS SYSCALL SYSENTER " +
+		"SYSENTER64 SYSEXIT SYSEXIT64 SYSRET TESTB TESTL TESTQ TESTW TEXT TPAUSE " +
+		"TZCNTL TZCNTQ TZCNTW UCOMISD UCOMISS UD1 UD2 UMONITOR UMWAIT UNDEF " +
+		"UNPCKHPD UNPCKHPS UNPCKLPD UNPCKLPS V4FMADDPS V4FMADDSS V4FNMADDPS " +
+		"V4FNMADDSS VADDPD VADDPS VADDSD VADDSS VADDSUBPD VADDSUBPS VAESDEC " +
+		"VAESDECLAST VAESENC VAESENCLAST VAESIMC VAESKEYGENASSIST VALIGND " +
+		"VALIGNQ VANDNPD VANDNPS VANDPD VANDPS VBLENDMPD VBLENDMPS VBLENDPD " +
+		"VBLENDPS VBLENDVPD VBLENDVPS VBROADCASTF128 VBROADCASTF32X2 " +
+		"VBROADCASTF32X4 VBROADCASTF32X8 VBROADCASTF64X2 VBROADCASTF64X4 " +
+		"VBROADCASTI128 VBROADCASTI32X2 VBROADCASTI32X4 VBROADCASTI32X8 " +
+		"VBROADCASTI64X2 VBROADCASTI64X4 VBROADCASTSD VBROADCASTSS VCMPPD VCMPPS " +
+		"VCMPSD VCMPSS VCOMISD VCOMISS VCOMPRESSPD VCOMPRESSPS VCVTDQ2PD " +
+		"VCVTDQ2PS VCVTPD2DQ VCVTPD2DQX VCVTPD2DQY VCVTPD2PS VCVTPD2PSX " +
+		"VCVTPD2PSY VCVTPD2QQ VCVTPD2UDQ VCVTPD2UDQX VCVTPD2UDQY VCVTPD2UQQ " +
+		"VCVTPH2PS VCVTPS2DQ VCVTPS2PD VCVTPS2PH VCVTPS2QQ VCVTPS2UDQ VCVTPS2UQQ " +
+		"VCVTQQ2PD VCVTQQ2PS VCVTQQ2PSX VCVTQQ2PSY VCVTSD2SI VCVTSD2SIQ " +
+		"VCVTSD2SS VCVTSD2USI VCVTSD2USIL VCVTSD2USIQ VCVTSI2SDL VCVTSI2SDQ " +
+		"VCVTSI2SSL VCVTSI2SSQ VCVTSS2SD VCVTSS2SI VCVTSS2SIQ VCVTSS2USI " +
+		"VCVTSS2USIL VCVTSS2USIQ VCVTTPD2DQ VCVTTPD2DQX VCVTTPD2DQY VCVTTPD2QQ " +
+		"VCVTTPD2UDQ VCVTTPD2UDQX VCVTTPD2UDQY VCVTTPD2UQQ VCVTTPS2DQ VCVTTPS2QQ " +
+		"VCVTTPS2UDQ VCVTTPS2UQQ VCVTTSD2SI VCVTTSD2SIQ VCVTTSD2USI VCVTTSD2USIL " +
+		"VCVTTSD2USIQ VCVTTSS2SI VCVTTSS2SIQ VCVTTSS2USI VCVTTSS2USIL " +
+		"VCVTTSS2USIQ VCVTUDQ2PD VCVTUDQ2PS VCVTUQQ2PD VCVTUQQ2PS VCVTUQQ2PSX " +
+		"VCVTUQQ2PSY VCVTUSI2SD VCVTUSI2SDL VCVTUSI2SDQ VCVTUSI2SS VCVTUSI2SSL " +
+		"VCVTUSI2SSQ VDBPSADBW VDIVPD VDIVPS VDIVSD VDIVSS VDPPD VDPPS VERR VERW " +
+		"VEXP2PD VEXP2PS VEXPANDPD VEXPANDPS VEXTRACTF128 VEXTRACTF32X4 " +
+		"VEXTRACTF32X8 VEXTRACTF64X2 VEXTRACTF64X4 VEXTRACTI128 VEXTRACTI32X4 " +
+		"VEXTRACTI32X8 VEXTRACTI64X2 VEXTRACTI64X4 VEXTRACTPS VFIXUPIMMPD " +
+		"VFIXUPIMMPS VFIXUPIMMSD VFIXUPIMMSS VFMADD132PD VFMADD132PS VFMADD132SD " +
+		"VFMADD132SS VFMADD213PD VFMADD213PS VFMADD213SD VFMADD213SS VFMADD231PD " +
+		"VFMADD231PS VFMADD231SD VFMADD231SS VFMADDSUB132PD VFMADDSUB132PS " +
+		"VFMADDSUB213PD VFMADDSUB213PS VFMADDSUB231PD VFMADDSUB231PS VFMSUB132PD " +
+		"VFMSUB132PS VFMSUB132SD VFMSUB132SS VFMSUB213PD VFMSUB213PS VFMSUB213SD " +
+		"VFMSUB213SS VFMSUB231PD VFMSUB231PS VFMSUB231SD VFMSUB231SS " +
+		"VFMSUBADD132PD VFMSUBADD132PS VFMSUBADD213PD VFMSUBADD213PS " +
+		"VFMSUBADD231PD VFMSUBADD231PS VFNMADD132PD VFNMADD132PS VFNMADD132SD " +
+		"VFNMADD132SS VFNMADD213PD VFNMADD213PS VFNMADD213SD VFNMADD213SS " +
+		"VFNMADD231PD VFNMADD231PS VFNMADD231SD VFNMADD231SS VFNMSUB132PD " +
+		"VFNMSUB132PS VFNMSUB132SD VFNMSUB132SS VFNMSUB213PD VFNMSUB213PS " +
+		"VFNMSUB213SD VFNMSUB213SS VFNMSUB231PD VFNMSUB231PS VFNMSUB231SD " +
+		"VFNMSUB231SS VFPCLASSPD VFPCLASSPDX VFPCLASSPDY VFPCLASSPDZ VFPCLASSPS " +
+		"VFPCLASSPSX VFPCLASSPSY VFPCLASSPSZ VFPCLASSSD VFPCLASSSS VGATHERDPD " +
+		"VGATHERDPS VGATHERPF0DPD VGATHERPF0DPS VGATHERPF0QPD VGATHERPF0QPS " +
+		"VGATHERPF1DPD VGATHERPF1DPS VGATHERPF1QPD VGATHERPF1QPS VGATHERQPD " +
+		"VGATHERQPS VGETEXPPD VGETEXPPS VGETEXPSD VGETEXPSS VGETMANTPD " +
+		"VGETMANTPS VGETMANTSD VGETMANTSS VGF2P8AFFINEINVQB VGF2P8AFFINEQB " +
+		"VGF2P8MULB VHADDPD VHADDPS VHSUBPD VHSUBPS VINSERTF128 VINSERTF32X4 " +
+		"VINSERTF32X8 VINSERTF64X2 VINSERTF64X4 VINSERTI128 VINSERTI32X4 " +
+		"VINSERTI32X8 VINSERTI64X2 VINSERTI64X4 VINSERTPS VLDDQU VLDMXCSR " +
+		"VMASKMOVDQU VMASKMOVPD VMASKMOVPS VMAXPD VMAXPS VMAXSD VMAXSS VMINPD " +
+		"VMINPS VMINSD VMINSS VMOVAPD VMOVAPS VMOVD VMOVDDUP VMOVDQA VMOVDQA32 " +
+		"VMOVDQA64 VMOVDQU VMOVDQU16 VMOVDQU32 VMOVDQU64 VMOVDQU8 VMOVHLPS " +
+		"VMOVHPD VMOVHPS VMOVLHPS VMOVLPD VMOVLPS VMOVMSKPD VMOVMSKPS VMOVNTDQ " +
+		"VMOVNTDQA VMOVNTPD VMOVNTPS VMOVQ VMOVSD VMOVSHDUP VMOVSLDUP VMOVSS " +
+		"VMOVUPD VMOVUPS VMPSADBW VMULPD VMULPS VMULSD VMULSS VORPD VORPS " +
+		"VP4DPWSSD VP4DPWSSDS VPABSB VPABSD VPABSQ VPABSW VPACKSSDW VPACKSSWB " +
+		"VPACKUSDW VPACKUSWB VPADDB VPADDD VPADDQ VPADDSB VPADDSW VPADDUSB " +
+		"VPADDUSW VPADDW VPALIGNR VPAND VPANDD VPANDN VPANDND VPANDNQ VPANDQ " +
+		"VPAVGB VPAVGW VPBLENDD VPBLENDMB VPBLENDMD VPBLENDMQ VPBLENDMW " +
+		"VPBLENDVB VPBLENDW VPBROADCASTB VPBROADCASTD VPBROADCASTMB2Q " +
+		"VPBROADCASTMW2D VPBROADCASTQ VPBROADCASTW VPCLMULQDQ VPCMPB VPCMPD " +
+		"VPCMPEQB VPCMPEQD VPCMPEQQ VPCMPEQW VPCMPESTRI VPCMPESTRM VPCMPGTB " +
+		"VPCMPGTD VPCMPGTQ VPCMPGTW VPCMPISTRI VPCMPISTRM VPCMPQ VPCMPUB VPCMPUD " +
+		"VPCMPUQ VPCMPUW VPCMPW VPCOMPRESSB VPCOMPRESSD VPCOMPRESSQ VPCOMPRESSW " +
+		"VPCONFLICTD VPCONFLICTQ VPDPBUSD VPDPBUSDS VPDPWSSD VPDPWSSDS " +
+		"VPERM2F128 VPERM2I128 VPERMB VPERMD VPERMI2B VPERMI2D VPERMI2PD " +
+		"VPERMI2PS VPERMI2Q VPERMI2W VPERMILPD VPERMILPS VPERMPD VPERMPS VPERMQ " +
+		"VPERMT2B VPERMT2D VPERMT2PD VPERMT2PS VPERMT2Q VPERMT2W VPERMW " +
+		"VPEXPANDB VPEXPANDD VPEXPANDQ VPEXPANDW VPEXTRB VPEXTRD VPEXTRQ VPEXTRW " +
+		"VPGATHERDD VPGATHERDQ VPGATHERQD VPGATHERQQ VPHADDD VPHADDSW VPHADDW " +
+		"VPHMINPOSUW VPHSUBD VPHSUBSW VPHSUBW VPINSRB VPINSRD VPINSRQ VPINSRW " +
+		"VPLZCNTD VPLZCNTQ VPMADD52HUQ VPMADD52LUQ VPMADDUBSW VPMADDWD " +
+		"VPMASKMOVD VPMASKMOVQ VPMAXSB VPMAXSD VPMAXSQ VPMAXSW VPMAXUB VPMAXUD " +
+		"VPMAXUQ VPMAXUW VPMINSB VPMINSD VPMINSQ VPMINSW VPMINUB VPMINUD VPMINUQ " +
+		"VPMINUW VPMOVB2M VPMOVD2M VPMOVDB VPMOVDW VPMOVM2B VPMOVM2D VPMOVM2Q " +
+		"VPMOVM2W VPMOVMSKB VPMOVQ2M VPMOVQB VPMOVQD VPMOVQW VPMOVSDB VPMOVSDW " +
+		"VPMOVSQB VPMOVSQD VPMOVSQW VPMOVSWB VPMOVSXBD VPMOVSXBQ VPMOVSXBW " +
+		"VPMOVSXDQ VPMOVSXWD VPMOVSXWQ VPMOVUSDB VPMOVUSDW VPMOVUSQB VPMOVUSQD " +
+		"VPMOVUSQW VPMOVUSWB VPMOVW2M VPMOVWB VPMOVZXBD VPMOVZXBQ VPMOVZXBW " +
+		"VPMOVZXDQ VPMOVZXWD VPMOVZXWQ VPMULDQ VPMULHRSW VPMULHUW VPMULHW " +
+		"VPMULLD VPMULLQ VPMULLW VPMULTISHIFTQB VPMULUDQ VPOPCNTB VPOPCNTD " +
+		"VPOPCNTQ VPOPCNTW VPOR VPORD VPORQ VPROLD VPROLQ VPROLVD VPROLVQ VPRORD " +
+		"VPRORQ VPRORVD VPRORVQ VPSADBW VPSCATTERDD VPSCATTERDQ VPSCATTERQD " +
+		"VPSCATTERQQ VPSHLDD VPSHLDQ VPSHLDVD VPSHLDVQ VPSHLDVW VPSHLDW VPSHRDD " +
+		"VPSHRDQ VPSHRDVD VPSHRDVQ VPSHRDVW VPSHRDW VPSHUFB VPSHUFBITQMB VPSHUFD " +
+		"VPSHUFHW VPSHUFLW VPSIGNB VPSIGND VPSIGNW VPSLLD VPSLLDQ VPSLLQ VPSLLVD " +
+		"VPSLLVQ VPSLLVW VPSLLW VPSRAD VPSRAQ VPSRAVD VPSRAVQ VPSRAVW VPSRAW " +
+		"VPSRLD VPSRLDQ VPSRLQ VPSRLVD VPSRLVQ VPSRLVW VPSRLW VPSUBB VPSUBD " +
+		"VPSUBQ VPSUBSB VPSUBSW VPSUBUSB VPSUBUSW VPSUBW VPTERNLOGD VPTERNLOGQ " +
+		"VPTEST VPTESTMB VPTESTMD VPTESTMQ VPTESTMW VPTESTNMB VPTESTNMD " +
+		"VPTESTNMQ VPTESTNMW VPUNPCKHBW VPUNPCKHDQ VPUNPCKHQDQ VPUNPCKHWD " +
+		"VPUNPCKLBW VPUNPCKLDQ VPUNPCKLQDQ VPUNPCKLWD VPXOR VPXORD VPXORQ " +
+		"VRANGEPD VRANGEPS VRANGESD VRANGESS VRCP14PD VRCP14PS VRCP14SD VRCP14SS " +
+		"VRCP28PD VRCP28PS VRCP28SD VRCP28SS VRCPPS VRCPSS VREDUCEPD VREDUCEPS " +
+		"VREDUCESD VREDUCESS VRNDSCALEPD VRNDSCALEPS VRNDSCALESD VRNDSCALESS " +
+		"VROUNDPD VROUNDPS VROUNDSD VROUNDSS VRSQRT14PD VRSQRT14PS VRSQRT14SD " +
+		"VRSQRT14SS VRSQRT28PD VRSQRT28PS VRSQRT28SD VRSQRT28SS VRSQRTPS " +
+		"VRSQRTSS VSCALEFPD VSCALEFPS VSCALEFSD VSCALEFSS VSCATTERDPD " +
+		"VSCATTERDPS VSCATTERPF0DPD VSCATTERPF0DPS VSCATTERPF0QPD VSCATTERPF0QPS " +
+		"VSCATTERPF1DPD VSCATTERPF1DPS VSCATTERPF1QPD VSCATTERPF1QPS VSCATTERQPD " +
+		"VSCATTERQPS VSHUFF32X4 VSHUFF64X2 VSHUFI32X4 VSHUFI64X2 VSHUFPD VSHUFPS " +
+		"VSQRTPD VSQRTPS VSQRTSD VSQRTSS VSTMXCSR VSUBPD VSUBPS VSUBSD VSUBSS " +
+		"VTESTPD VTESTPS VUCOMISD VUCOMISS VUNPCKHPD VUNPCKHPS VUNPCKLPD " +
+		"VUNPCKLPS VXORPD VXORPS VZEROALL VZEROUPPER WAIT WBINVD WORD WRFSBASEL " +
+		"WRFSBASEQ WRGSBASEL WRGSBASEQ WRMSR WRPKRU XABORT XACQUIRE XADDB XADDL " +
+		"XADDQ XADDW XBEGIN XCHGB XCHGL XCHGQ XCHGW XEND XGETBV XLAT XORB XORL " +
+		"XORPD XORPS XORQ XORW XRELEASE XRSTOR XRSTOR64 XRSTORS XRSTORS64 XSAVE " +
+		"XSAVE64 XSAVEC XSAVEC64 XSAVEOPT XSAVEOPT64 XSAVES XSAVES64 XSETBV XTEST",
+}
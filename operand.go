@@ -0,0 +1,142 @@
+package asmfmt
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/asmfmt/lexer"
+)
+
+// normalizeOperand returns the operand with whitespace between tokens removed,
+// so "8 ( SI ) ( BX * 4 )" becomes "8(SI)(BX*4)" and "$ (1 << 3)" becomes "$(1<<3)".
+// A single space is kept where removing it would join two tokens,
+// like two identifiers, and around comments and continuations.
+// Operands containing statements separated by semicolons are not changed.
+func normalizeOperand(s string) string {
+	toks := lexer.Scan(s)
+	for _, t := range toks {
+		if t.Kind == lexer.Semicolon || t.Unterminated {
+			return s
+		}
+	}
+	var b strings.Builder
+	var prev *lexer.Token
+	space := false
+	for i := range toks {
+		t := &toks[i]
+		if t.Kind == lexer.Space {
+			space = true
+			continue
+		}
+		if space && prev != nil && needSpace(*prev, *t) {
+			b.WriteByte(' ')
+		}
+		space = false
+		b.WriteString(t.Text)
+		prev = t
+	}
+	return b.String()
+}
+
+// needSpace returns true if a and b must be separated by a space,
+// because they would be scanned as different tokens when joined.
+func needSpace(a, b lexer.Token) bool {
+	switch {
+	case a.Kind == lexer.LineComment, a.Kind == lexer.BlockComment, a.Kind == lexer.Continuation,
+		b.Kind == lexer.LineComment, b.Kind == lexer.BlockComment, b.Kind == lexer.Continuation:
+		return true
+	}
+	joined := lexer.Scan(a.Text + b.Text)
+	return len(joined) != 2 || joined[0].Text != a.Text || joined[0].Kind != a.Kind
+}
+
+// normalizeParams returns the parameters of the statement,
+// normalized as given by the options.
+func (o *Options) normalizeParams(st statement) []string {
+	// The parameters may be shared with a parsed node.
+	params := make([]string, len(st.params))
+	for i, p := range st.params {
+		if o.NormalizeOperands {
+			p = normalizeOperand(p)
+		}
+		if o.NormalizeNumbers {
+			p = normalizeNumbers(p, o.UpperHex)
+		}
+		params[i] = p
+	}
+	if o.NormalizeNumbers && o.PadData && strings.EqualFold(st.instruction, "DATA") && len(params) == 2 {
+		params[1] = padData(params[0], params[1])
+	}
+	return params
+}
+
+// normalizeNumbers returns the operand with the prefixes of integer
+// constants in lower case, like 0x1f, 0b101 and 0o17.
+// Hex digits are upper case if upper is set, otherwise lower case.
+func normalizeNumbers(s string, upper bool) string {
+	toks := lexer.Scan(s)
+	var b strings.Builder
+	for _, t := range toks {
+		if t.Kind == lexer.Number {
+			t.Text = normalizeNumber(t.Text, upper)
+		}
+		b.WriteString(t.Text)
+	}
+	return b.String()
+}
+
+// normalizeNumber returns the integer constant with a lower case prefix.
+// Other numbers are returned unchanged.
+func normalizeNumber(s string, upper bool) string {
+	if len(s) < 3 || s[0] != '0' {
+		return s
+	}
+	prefix, digits := strings.ToLower(s[:2]), s[2:]
+	valid := "01_"
+	switch prefix {
+	case "0x":
+		valid = "0123456789abcdefABCDEF_"
+		if upper {
+			digits = strings.ToUpper(digits)
+		} else {
+			digits = strings.ToLower(digits)
+		}
+	case "0o":
+		valid = "01234567_"
+	case "0b":
+	default:
+		return s
+	}
+	if strings.Trim(digits, valid) != "" {
+		// Floating point or invalid constant.
+		return s
+	}
+	return prefix + digits
+}
+
+// padData returns the value of a DATA directive with hex constants
+// padded with zeros to the width of the data, like $0x00ff for width 2.
+// Other values are returned unchanged.
+func padData(dst, value string) string {
+	i := strings.LastIndex(dst, "/")
+	if i < 0 {
+		return value
+	}
+	width, err := strconv.Atoi(strings.TrimSpace(dst[i+1:]))
+	if err != nil || width <= 0 || width > 8 {
+		return value
+	}
+	toks := lexer.Scan(value)
+	if len(toks) != 2 || toks[0].Kind != lexer.Dollar || toks[1].Kind != lexer.Number {
+		return value
+	}
+	n := toks[1].Text
+	if len(n) < 3 || strings.ToLower(n[:2]) != "0x" || strings.Contains(n, "_") {
+		return value
+	}
+	digits := n[2:]
+	if len(digits) >= 2*width || strings.Trim(digits, "0123456789abcdefABCDEF") != "" {
+		return value
+	}
+	return "$" + n[:2] + strings.Repeat("0", 2*width-len(digits)) + digits
+}
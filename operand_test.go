@@ -0,0 +1,148 @@
+package asmfmt
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeOperand(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{in: "8 ( SI ) ( BX * 4 )", want: "8(SI)(BX*4)"},
+		{in: "$ (1 << 3)", want: "$(1<<3)"},
+		{in: "x+ 8(FP)", want: "x+8(FP)"},
+		{in: "ret+24 (FP)", want: "ret+24(FP)"},
+		{in: "(16*0 + 8*1)(AX)", want: "(16*0+8*1)(AX)"},
+		{in: "$ -1", want: "$-1"},
+		{in: "$(1 < < 2)", want: "$(1< <2)"},
+		{in: "$(a - -1)", want: "$(a--1)"},
+		{in: "$(1 / / 2)", want: "$(1/ /2)"},
+		{in: "$\"a b\"", want: "$\"a b\""},
+		{in: "$' '", want: "$' '"},
+		{in: "[ R0 - R3 ]", want: "[R0-R3]"},
+		{in: "a b", want: "a b"},
+		{in: "$1 /* one */", want: "$1 /* one */"},
+		{in: "AX \\", want: "AX \\"},
+		{in: "BX; MOVQ CX", want: "BX; MOVQ CX"},
+	}
+	for _, test := range tests {
+		if got := normalizeOperand(test.in); got != test.want {
+			t.Errorf("%q: got %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestNormalizeOperands(t *testing.T) {
+	input := "#define LOAD(off) MOVQ (off + 8)(SP), AX\n\nTEXT ·f(SB),$0\nMOVQ x+ 8(FP), AX\nLEAQ 8 ( SI ) ( BX * 4 ), BX\nMOVQ $ (1 << 3), CX\nLOAD( 8 )\nRET\n"
+	want := "#define LOAD(off) MOVQ (off + 8)(SP), AX\n\nTEXT ·f(SB), $0\n\tMOVQ x+8(FP), AX\n\tLEAQ 8(SI)(BX*4), BX\n\tMOVQ $(1<<3), CX\n\tLOAD( 8 )\n\tRET\n"
+	got, err := FormatWithOptions(strings.NewReader(input), Options{NormalizeOperands: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+// Normalizing operands of the testdata files must be idempotent.
+func TestNormalizeOperandsTestdata(t *testing.T) {
+	match, err := filepath.Glob("testdata/*.in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := Options{NormalizeOperands: true}
+	for _, in := range match {
+		src, err := ioutil.ReadFile(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		once, err := FormatWithOptions(bytes.NewReader(src), opts)
+		if err != nil {
+			t.Error(in, "-", err)
+			continue
+		}
+		twice, err := FormatWithOptions(bytes.NewReader(once), opts)
+		if err != nil {
+			t.Error(in, "-", err)
+			continue
+		}
+		if !bytes.Equal(once, twice) {
+			t.Errorf("%s: not idempotent:\n%s", in, UnifiedDiff(in, once, twice, 3))
+		}
+	}
+}
+
+func TestNormalizeNumbers(t *testing.T) {
+	tests := []struct {
+		in, want string
+		upper    bool
+	}{
+		{in: "$0X1F", want: "$0x1f"},
+		{in: "$0x1f", want: "$0x1F", upper: true},
+		{in: "$0B101", want: "$0b101"},
+		{in: "$0O17", want: "$0o17"},
+		{in: "$(0XFF<<8)", want: "$(0xff<<8)"},
+		{in: "$0xFF_FF", want: "$0xff_ff"},
+		{in: "$0X1P-2", want: "$0X1P-2"},
+		{in: "$1E3", want: "$1E3"},
+		{in: "$017", want: "$017"},
+		{in: "$\"0XFF\"", want: "$\"0XFF\""},
+		{in: "0XAB(SI)", want: "0xab(SI)"},
+	}
+	for _, test := range tests {
+		if got := normalizeNumbers(test.in, test.upper); got != test.want {
+			t.Errorf("%q: got %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestPadData(t *testing.T) {
+	tests := []struct {
+		dst, value, want string
+	}{
+		{dst: "x<>+0(SB)/2", value: "$0xff", want: "$0x00ff"},
+		{dst: "x<>+0(SB)/8", value: "$0x1", want: "$0x0000000000000001"},
+		{dst: "x<>+0(SB)/1", value: "$0x1", want: "$0x01"},
+		{dst: "x<>+0(SB)/2", value: "$0x12345", want: "$0x12345"},
+		{dst: "x<>+0(SB)/4", value: "$255", want: "$255"},
+		{dst: "x<>+0(SB)/4", value: "$-0x1", want: "$-0x1"},
+		{dst: "x<>+0(SB)/4", value: "$x<>(SB)", want: "$x<>(SB)"},
+		{dst: "x<>+0(SB)/8", value: "$\"abc\"", want: "$\"abc\""},
+		{dst: "x<>+0(SB)", value: "$0x1", want: "$0x1"},
+	}
+	for _, test := range tests {
+		if got := padData(test.dst, test.value); got != test.want {
+			t.Errorf("%q, %q: got %q, want %q", test.dst, test.value, got, test.want)
+		}
+	}
+}
+
+func TestNormalizeNumbersFormat(t *testing.T) {
+	input := "#define MASK $0XFF\n#define CLEAR(r) \\\n\tMOVQ $0X1F, r \\\n\tANDQ $0XFF, r\n\nDATA tbl<>+0(SB)/4, $0XAB\nDATA tbl<>+4(SB)/2, $0x1 // $0XFF\n\nTEXT ·f(SB),$0\nMOVQ $0XFF, AX\nANDQ MASK, AX\nRET\n"
+	tests := []struct {
+		opts Options
+		want string
+	}{
+		{
+			opts: Options{NormalizeNumbers: true},
+			want: "#define MASK $0XFF\n#define CLEAR(r) \\\n\tMOVQ $0X1F, r \\\n\tANDQ $0XFF, r\n\nDATA tbl<>+0(SB)/4, $0xab\nDATA tbl<>+4(SB)/2, $0x1 // $0XFF\n\nTEXT ·f(SB), $0\n\tMOVQ $0xff, AX\n\tANDQ MASK, AX\n\tRET\n",
+		},
+		{
+			opts: Options{NormalizeNumbers: true, UpperHex: true, PadData: true},
+			want: "#define MASK $0XFF\n#define CLEAR(r) \\\n\tMOVQ $0X1F, r \\\n\tANDQ $0XFF, r\n\nDATA tbl<>+0(SB)/4, $0x000000AB\nDATA tbl<>+4(SB)/2, $0x0001 // $0XFF\n\nTEXT ·f(SB), $0\n\tMOVQ $0xFF, AX\n\tANDQ MASK, AX\n\tRET\n",
+		},
+	}
+	for _, test := range tests {
+		got, err := FormatWithOptions(strings.NewReader(input), test.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != test.want {
+			t.Errorf("%+v: got:\n%q\nwant:\n%q", test.opts, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package asmfmt
+
+import "strings"
+
+// Options contains formatting options.
+// The zero value gives the formatting done by Format.
+type Options struct {
+	// Filename is the name of the input file.
+	// It is used for reporting errors, and for inferring the architecture.
+	Filename string
+
+	// Arch is the GOARCH of the input, like "amd64" or "arm64".
+	// If empty, it is inferred from the Filename suffix,
+	// or the build constraints at the start of the input, like InferArch.
+	Arch string
+
+	// Warn is called for problems that do not prevent formatting,
+	// such as unterminated comments and literals.
+	// If nil, they are returned as errors.
+	Warn func(err *Error)
+
+	// SelfCheck formats the output again, and returns an error
+	// if it changes. The error is of kind KindNotIdempotent,
+	// and has the position of the first change in the output.
+	SelfCheck bool
+
+	// Indent is the string used for each level of indentation.
+	// If empty, a tab is used.
+	Indent string
+
+	// KeepBlockComments will keep single line block comments (/* comment */)
+	// instead of converting them to line comments (// comment).
+	KeepBlockComments bool
+
+	// MaxBlankLines is the maximum number of consecutive empty lines
+	// kept from the input. If 0, one empty line is kept.
+	// If negative, all empty lines are removed.
+	MaxBlankLines int
+
+	// NoCommentSpace disables inserting a space after "//"
+	// in comments on separate lines.
+	NoCommentSpace bool
+
+	// NoCommentAlign disables aligning trailing comments in a block.
+	// Trailing comments will be separated from the statement by a space.
+	NoCommentAlign bool
+
+	// CommentColumn is the minimum column of trailing comments,
+	// not counting indentation.
+	CommentColumn int
+
+	// NormalizeMnemonics changes the case of instructions and directives
+	// known by the Go assembler to the case it uses, which is upper case
+	// on all architectures except wasm. Labels and macros defined
+	// in the file are not changed.
+	// If the architecture is not known, the instructions of all
+	// architectures except wasm are used.
+	NormalizeMnemonics bool
+
+	// NormalizeOperands removes whitespace inside operands, so equivalent
+	// operands are written the same way, like "8(SI)(BX*4)", "x+8(FP)"
+	// and "$(1<<3)". Macros are not changed.
+	NormalizeOperands bool
+
+	// NormalizeNumbers writes the prefixes of integer constants in
+	// operands in lower case, like 0x1f, 0b101 and 0o17.
+	// Hex digits are lower case, unless UpperHex is set.
+	// Macros and comments are not changed.
+	NormalizeNumbers bool
+
+	// UpperHex writes hex digits in upper case, like 0x1F,
+	// when NormalizeNumbers is set.
+	UpperHex bool
+
+	// PadData pads hex values of DATA directives with zeros to the width
+	// of the data, like "DATA x<>+0(SB)/2, $0x00ff",
+	// when NormalizeNumbers is set.
+	PadData bool
+}
+
+// indent returns the indentation for the level.
+func (o *Options) indent(level int) string {
+	if o.Indent == "" {
+		return strings.Repeat("\t", level)
+	}
+	return strings.Repeat(o.Indent, level)
+}
+
+// arch returns the architecture given by the options, or "" if unknown.
+// The build constraints are checked while formatting.
+func (o *Options) arch() string {
+	if o.Arch != "" {
+		return o.Arch
+	}
+	return fileArch(o.Filename)
+}
+
+// maxBlankLines returns the maximum number of consecutive empty lines.
+func (o *Options) maxBlankLines() int {
+	switch {
+	case o.MaxBlankLines == 0:
+		return 1
+	case o.MaxBlankLines < 0:
+		return 0
+	}
+	return o.MaxBlankLines
+}
+
+// comment returns the trailing comment of the statement.
+func (o *Options) comment(st statement) string {
+	if st.blockComment && o.KeepBlockComments {
+		return "/* " + st.comment + " */"
+	}
+	return "// " + st.comment
+}
@@ -0,0 +1,390 @@
+package asmfmt
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/klauspost/asmfmt/lexer"
+)
+
+// Parse the input and return the parsed file.
+// The parser accepts the same input as Format.
+// If any error is encountered, no file will be returned.
+func Parse(in io.Reader) (f *File, err error) {
+	defer recoverError("", &err)
+	var nodes []Node
+	err = parse(in, &Options{}, func(n Node) {
+		nodes = append(nodes, n)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &File{Nodes: groupFuncs(nodes)}, nil
+}
+
+// parse will parse the input and call emit for each node in source order.
+// Errors in the input are returned as an ErrorList when all input has been read.
+func parse(in io.Reader, opts *Options, emit func(Node)) error {
+	src := bufio.NewReaderSize(in, 512<<10)
+	p := parser{emit: emit, opts: opts, defines: make(map[string]struct{})}
+	for {
+		data, _, err := src.ReadLine()
+		if err == io.EOF {
+			p.end()
+			p.errors.sort()
+			return p.errors.Err()
+		}
+		if err != nil {
+			return err
+		}
+		p.addLine(data)
+	}
+}
+
+type parser struct {
+	line    int           // Current line number
+	block   *BlockComment // Block comment being read, if any
+	inline  bool          // Parsing a line with a block comment converted to a line comment
+	defines map[string]struct{}
+	emit    func(Node)
+	opts    *Options
+	errors  ErrorList
+}
+
+// Add a new input line.
+func (p *parser) addLine(b []byte) {
+	p.line++
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		p.error(p.pos(i+1), KindZeroByte, "zero (0) byte in input. file is unlikely an assembler file")
+		return
+	}
+	// Only one carriage return is removed when reading the line.
+	p.parseLine(string(bytes.TrimRight(b, "\r")), 1)
+}
+
+// error adds an error at the position.
+// If the error can be a warning and the options has a warning function,
+// it is called instead.
+func (p *parser) error(pos Pos, kind ErrorKind, format string, a ...interface{}) {
+	if kind.warning() && p.opts.Warn != nil {
+		p.opts.Warn(&Error{Filename: p.opts.Filename, Pos: pos, Kind: kind, Msg: fmt.Sprintf(format, a...)})
+		return
+	}
+	p.errors.add(p.opts.Filename, pos, kind, format, a...)
+}
+
+// end must be called when there is no more input.
+func (p *parser) end() {
+	if p.block != nil {
+		p.error(p.block.Position, KindUnterminatedComment, "comment not terminated")
+		p.block.Unterminated = true
+		p.emit(p.block)
+		p.block = nil
+	}
+}
+
+// pos returns the position of the column on the current line.
+func (p *parser) pos(col int) Pos {
+	return Pos{Line: p.line, Column: col}
+}
+
+// parseLine will parse s, which starts at column col of the current line.
+func (p *parser) parseLine(s string, col int) {
+	// Inside block comment
+	if p.block != nil {
+		sc := lexer.Scanner{InComment: true}
+		c := sc.Scan(s)[0]
+		if c.Unterminated {
+			p.block.Lines = append(p.block.Lines, s)
+			return
+		}
+		ends := c.End() - 2
+		b := p.block
+		p.block = nil
+		b.Lines = append(b.Lines, s[:ends])
+		b.End = p.pos(col + ends)
+		s, col = trimSpace(s[ends+2:], col+ends+2)
+		if strings.HasSuffix(s, `\`) {
+			b.Continued = true
+			if len(s) == 1 {
+				s = ""
+			}
+		}
+		p.emit(b)
+		if len(s) == 0 {
+			return
+		}
+	}
+	s, col = trimSpace(s, col)
+	toks := lexer.Scan(s)
+
+	// Comment is the the only line content.
+	if len(toks) > 0 && toks[0].Kind == lexer.LineComment {
+		p.emit(&Comment{Position: p.pos(col), Text: s[2:]})
+		return
+	}
+
+	// An empty line comment after other content is removed,
+	// like for statements.
+	if n := len(toks); n > 1 && toks[n-1].Kind == lexer.LineComment && strings.TrimSpace(toks[n-1].Text[2:]) == "" {
+		s = strings.TrimRightFunc(s[:toks[n-1].Offset], unicode.IsSpace)
+		toks = lexer.Scan(s)
+	}
+
+	// Handle block comments.
+	// Comments in continued lines are kept as part of the statement,
+	// unless they continue on the next line.
+	if i := blockComment(toks); i >= 0 && (!strings.HasSuffix(s, `\`) || unterminatedComment(toks)) {
+		starts, ends := toks[i].Offset, -1
+		if !toks[i].Unterminated {
+			ends = toks[i].End() - 2
+		}
+		// Single line comment ending at the end of the line.
+		// Trailing commas and semicolons are removed from statements.
+		single := ends >= 0 && strings.TrimFunc(s[ends+2:], func(r rune) bool { return r == ',' || isTrailing(r) }) == ""
+
+		// A comment followed by code is kept as it is.
+		if starts == 0 && ends >= 0 && !single {
+			p.emit(&Stmt{Position: p.pos(col), Op: s, Macro: true})
+			return
+		}
+
+		if pre := strings.TrimSpace(s[:starts]); len(pre) > 0 {
+			// Add items before the comment section as a line.
+			if single {
+				comm := strings.TrimSpace(s[starts+2 : ends])
+				p.inline = true
+				p.parseLine(pre+" //"+comm, col)
+				p.inline = false
+				return
+			}
+			if ends < 0 && strings.HasSuffix(s, `\`) {
+				// The statement is continued after the comment.
+				pre += ` \`
+			}
+			p.parseLine(pre, col)
+		}
+
+		if single {
+			p.emit(&Comment{Position: p.pos(col + starts), Text: strings.TrimSpace(s[starts+2 : ends]), Block: true})
+			return
+		}
+
+		b := &BlockComment{Position: p.pos(col + starts)}
+		if ends < 0 {
+			b.Lines = []string{s[starts+2:]}
+			p.block = b
+			return
+		}
+		// The comment ends before the end of the line.
+		b.Lines = []string{s[starts+2 : ends]}
+		b.End = p.pos(col + ends)
+		p.emit(b)
+		p.parseLine(s[ends+2:], col+ends+2)
+		return
+	}
+
+	if len(s) == 0 {
+		p.emit(&Blank{Position: p.pos(1)})
+		return
+	}
+
+	st := newStatement(s, p.defines)
+	if st == nil {
+		return
+	}
+	if def := st.define(); def != "" {
+		p.defines[def] = struct{}{}
+	}
+	if st.instruction == "package" {
+		if _, ok := p.defines["package"]; !ok {
+			p.error(p.pos(col), KindGoFile, "package instruction found. Go files are not supported")
+		}
+	}
+
+	// Move anything that isn't a comment to the next line
+	if st.isLabel() && len(st.params) > 0 && !st.continued {
+		idx := strings.Index(s, ":")
+		p.checkLiterals(s[:idx+1], col)
+		p.emit(newStatement(s[:idx+1], p.defines).node(p.pos(col)))
+		p.parseLine(s[idx+1:], col+idx+1)
+		return
+	}
+	if !st.isPreProcessor() || st.define() != "" {
+		p.checkLiterals(s, col)
+	}
+	n := st.node(p.pos(col))
+	n.CommentBlock = p.inline && len(n.Comment) > 0
+	p.emit(n)
+}
+
+// checkLiterals will report the first string or character literal in s
+// that is not terminated at the end of the line.
+// s starts at column col.
+func (p *parser) checkLiterals(s string, col int) {
+	for _, t := range lexer.Scan(s) {
+		if !t.Unterminated {
+			continue
+		}
+		switch t.Kind {
+		case lexer.String:
+			p.error(p.pos(col+t.Offset), KindUnterminatedString, "string literal not terminated")
+			return
+		case lexer.Char:
+			p.error(p.pos(col+t.Offset), KindUnterminatedChar, "character literal not terminated")
+			return
+		}
+	}
+}
+
+// blockComment returns the index of the first block comment in toks,
+// or -1 if there is none.
+// Block comments followed by a line comment are kept
+// as part of the statement, so -1 is also returned for those.
+// This is also the case for terminated comments inside a statement,
+// but a block comment ending the line is returned instead.
+// A comment continuing on the next line is always returned.
+func blockComment(toks []lexer.Token) int {
+	if len(toks) > 0 && unterminatedComment(toks) {
+		return len(toks) - 1
+	}
+	for i, t := range toks {
+		if t.Kind != lexer.BlockComment {
+			continue
+		}
+		for _, t := range toks[i+1:] {
+			if t.Kind == lexer.LineComment {
+				return -1
+			}
+		}
+		end := len(toks)
+		for end > i+1 && trailing(toks[end-1]) {
+			end--
+		}
+		if i > 0 && i < end-1 && !t.Unterminated {
+			if toks[end-1].Kind == lexer.BlockComment {
+				return end - 1
+			}
+			return -1
+		}
+		return i
+	}
+	return -1
+}
+
+// trailing returns true if the token is removed
+// from the end of statements.
+func trailing(t lexer.Token) bool {
+	return t.Kind == lexer.Comma || t.Kind == lexer.Semicolon || t.Kind == lexer.Space
+}
+
+// unterminatedComment returns true if the tokens end with
+// a block comment that is not terminated.
+func unterminatedComment(toks []lexer.Token) bool {
+	last := toks[len(toks)-1]
+	return last.Kind == lexer.BlockComment && last.Unterminated
+}
+
+// trimSpace removes leading and trailing whitespace from s,
+// which starts at column col.
+// The trimmed string and its starting column is returned.
+func trimSpace(s string, col int) (string, int) {
+	t := strings.TrimLeftFunc(s, unicode.IsSpace)
+	col += len(s) - len(t)
+	return strings.TrimRightFunc(t, unicode.IsSpace), col
+}
+
+// node returns the statement as a node.
+func (st *statement) node(pos Pos) *Stmt {
+	n := &Stmt{
+		Position:     pos,
+		Op:           st.instruction,
+		Args:         st.params,
+		Comment:      st.comment,
+		Macro:        st.macro,
+		Continued:    st.continued,
+		CommentBlock: st.blockComment,
+	}
+	if st.contComment {
+		// Comment only, stored in the instruction.
+		n.Op = ""
+		n.Comment = strings.TrimSpace(strings.TrimPrefix(st.instruction, `\ //`))
+	}
+	return n
+}
+
+// statement returns the node as a statement.
+func (s *Stmt) statement() statement {
+	st := statement{
+		instruction:  s.Op,
+		params:       s.Args,
+		comment:      s.Comment,
+		function:     s.Macro,
+		macro:        s.Macro,
+		continued:    s.Continued,
+		blockComment: s.CommentBlock,
+	}
+	if st.instruction == "" && st.continued && len(st.comment) > 0 {
+		st.instruction = fmt.Sprintf("\\ // %s", st.comment)
+		st.comment = ""
+		st.function = true
+		st.contComment = true
+	}
+	if len(st.params) == 0 && !st.isLabel() {
+		st.function = true
+	}
+	return st
+}
+
+// groupFuncs will collect the statements following a TEXT directive
+// into functions.
+func groupFuncs(nodes []Node) []Node {
+	res := make([]Node, 0, len(nodes))
+	var fn *Func
+	for _, n := range nodes {
+		st, ok := n.(*Stmt)
+		if !ok || st.Kind() != Directive || st.Continued || !isSection(st.Op) {
+			if fn != nil {
+				fn.Body = append(fn.Body, n)
+			} else {
+				res = append(res, n)
+			}
+			continue
+		}
+		if fn != nil {
+			// Comments and blank lines before the directive are not part of the function.
+			i := len(fn.Body)
+			for i > 0 && !isStmt(fn.Body[i-1]) {
+				i--
+			}
+			res = append(res, fn.Body[i:]...)
+			fn.Body = fn.Body[:i]
+			fn = nil
+		}
+		if strings.ToUpper(st.Op) == "TEXT" {
+			fn = &Func{Text: st}
+			res = append(res, fn)
+			continue
+		}
+		res = append(res, st)
+	}
+	return res
+}
+
+// isSection returns true if op starts a new section of the file.
+func isSection(op string) bool {
+	switch strings.ToUpper(op) {
+	case "TEXT", "DATA", "GLOBL":
+		return true
+	}
+	return false
+}
+
+func isStmt(n Node) bool {
+	_, ok := n.(*Stmt)
+	return ok
+}
@@ -0,0 +1,106 @@
+package asmfmt
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	input := `#include "textflag.h"
+
+// func add(a, b int) int
+TEXT ·add(SB), NOSPLIT, $0-24
+	MOVQ a+0(FP), AX /* first */
+loop: ADDQ b+8(FP), AX // add
+	MOVQ AX, ret+16(FP)
+	RET
+
+/* table
+ * of values */
+DATA table<>+0(SB)/8, $1
+GLOBL table<>(SB), RODATA, $8
+`
+	f, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	type want struct {
+		kind string
+		pos  Pos
+		text string
+	}
+	var got []want
+	f.Walk(func(n Node) {
+		var w want
+		w.pos = n.Pos()
+		switch n := n.(type) {
+		case *Func:
+			w.kind, w.text = "func", n.Name()
+		case *Stmt:
+			w.kind = n.Kind().String()
+			w.text = strings.Join(append([]string{n.Op}, n.Args...), "|")
+			if n.Comment != "" {
+				w.text += " #" + n.Comment
+			}
+		case *Comment:
+			w.kind, w.text = "comment", n.Text
+		case *BlockComment:
+			w.kind, w.text = "block", n.Text()
+		case *Blank:
+			w.kind = "blank"
+		}
+		got = append(got, w)
+	})
+	expect := []want{
+		{"preprocessor", Pos{1, 1}, `#include|"textflag.h"`},
+		{"blank", Pos{2, 1}, ""},
+		{"comment", Pos{3, 1}, " func add(a, b int) int"},
+		{"func", Pos{4, 1}, "·add(SB)"},
+		{"directive", Pos{4, 1}, "TEXT|·add(SB)|NOSPLIT|$0-24"},
+		{"instruction", Pos{5, 2}, "MOVQ|a+0(FP)|AX #first"},
+		{"label", Pos{6, 1}, "loop:"},
+		{"instruction", Pos{6, 7}, "ADDQ|b+8(FP)|AX #add"},
+		{"instruction", Pos{7, 2}, "MOVQ|AX|ret+16(FP)"},
+		{"instruction", Pos{8, 2}, "RET"},
+		{"blank", Pos{9, 1}, ""},
+		{"block", Pos{10, 1}, " table\n * of values "},
+		{"directive", Pos{12, 1}, "DATA|table<>+0(SB)/8|$1"},
+		{"directive", Pos{13, 1}, "GLOBL|table<>(SB)|RODATA|$8"},
+	}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("got:\n%v\nwant:\n%v", got, expect)
+	}
+	if fns := f.Funcs(); len(fns) != 1 || len(fns[0].Body) != 5 {
+		t.Errorf("unexpected functions: %v", fns)
+	}
+}
+
+func TestParseTestdata(t *testing.T) {
+	match, err := filepath.Glob("testdata/*.in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, in := range match {
+		f, err := os.Open(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file, err := Parse(f)
+		f.Close()
+		if err != nil {
+			t.Error(in, "-", err)
+			continue
+		}
+		line := 0
+		file.Walk(func(n Node) {
+			if p := n.Pos(); p.Line < line || p.Column < 1 {
+				t.Errorf("%s: position %v out of order", in, p)
+			} else {
+				line = p.Line
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package asmfmt
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// LineRange is a range of lines from Start to End, both included.
+// The first line is 1.
+type LineRange struct {
+	Start, End int
+}
+
+// String returns the range as "start:end".
+func (r LineRange) String() string {
+	return fmt.Sprintf("%d:%d", r.Start, r.End)
+}
+
+// contains returns true if the line is in the range.
+func (r LineRange) contains(line int) bool {
+	return line >= r.Start && line <= r.End
+}
+
+// FormatRange formats the lines of the input within the ranges
+// and returns the resulting data. Lines outside the ranges are unchanged.
+//
+// The whole input is parsed, and statements are aligned with the
+// surrounding block, so the formatted lines are identical to the lines
+// produced when formatting the whole input.
+// Empty lines inserted by the formatter belong to the following line.
+func FormatRange(in io.Reader, opts Options, ranges ...LineRange) ([]byte, error) {
+	for _, r := range ranges {
+		if r.Start < 1 || r.End < r.Start {
+			return nil, fmt.Errorf("invalid line range %v", r)
+		}
+	}
+	src, err := ioutil.ReadAll(in)
+	if err != nil {
+		return nil, err
+	}
+	out, srcLines, err := formatLines(src, opts)
+	if err != nil {
+		return nil, err
+	}
+	inRange := func(line int) bool {
+		for _, r := range ranges {
+			if r.contains(line) {
+				return true
+			}
+		}
+		return false
+	}
+
+	inLines, outLines := splitLines(src), splitLines(out)
+	var dst bytes.Buffer
+	for i := 0; i < len(inLines); i++ {
+		if !inRange(i + 1) {
+			dst.Write(inLines[i])
+			continue
+		}
+		// Replace the lines from start to end with the formatted lines.
+		start, end := i+1, i+1
+		for end < len(inLines) && inRange(end+1) {
+			end++
+		}
+		for j, line := range outLines {
+			if srcLines[j] >= start && srcLines[j] <= end {
+				dst.Write(line)
+			}
+		}
+		i = end - 1
+	}
+	return dst.Bytes(), nil
+}
+
+// formatLines formats the input and returns the output with
+// the source line of each output line.
+// Lines inserted by the formatter get the source line of the following line.
+func formatLines(src []byte, opts Options) (out []byte, lines []int, err error) {
+	defer recoverError(opts.Filename, &err)
+	dst := &bytes.Buffer{}
+	state := fstate{out: dst, opts: opts, arch: opts.arch(), trackLines: true}
+	err = parse(bytes.NewReader(src), &opts, state.addNode)
+	if err != nil {
+		return nil, nil, err
+	}
+	state.flush()
+	if opts.SelfCheck {
+		if err := selfCheck(dst.Bytes(), opts); err != nil {
+			return nil, nil, err
+		}
+	}
+	lines = state.srcLines
+	for i := len(lines) - 2; i >= 0; i-- {
+		if lines[i] == 0 {
+			lines[i] = lines[i+1]
+		}
+	}
+	return dst.Bytes(), lines, nil
+}
+
+// splitLines splits b after each newline.
+// The last line is included, even if it doesn't end with a newline.
+func splitLines(b []byte) [][]byte {
+	lines := bytes.SplitAfter(b, []byte("\n"))
+	if len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
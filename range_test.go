@@ -0,0 +1,108 @@
+package asmfmt
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestFormatRange(t *testing.T) {
+	input := `TEXT ·add(SB),NOSPLIT,$0-24
+MOVOU (SI),X0 // load
+	ADDQ    $1,AX   // add
+  MOVQ AX,ret+16(FP)
+loop:    RET
+`
+	tests := []struct {
+		ranges []LineRange
+		want   string
+	}{
+		{
+			ranges: []LineRange{{3, 3}},
+			want: `TEXT ·add(SB),NOSPLIT,$0-24
+MOVOU (SI),X0 // load
+	ADDQ  $1, AX         // add
+  MOVQ AX,ret+16(FP)
+loop:    RET
+`,
+		},
+		{
+			ranges: []LineRange{{1, 1}, {4, 5}},
+			want: `TEXT ·add(SB), NOSPLIT, $0-24
+MOVOU (SI),X0 // load
+	ADDQ    $1,AX   // add
+	MOVQ  AX, ret+16(FP)
+
+loop:
+	RET
+`,
+		},
+		{
+			ranges: []LineRange{{2, 10}},
+			want: `TEXT ·add(SB),NOSPLIT,$0-24
+	MOVOU (SI), X0       // load
+	ADDQ  $1, AX         // add
+	MOVQ  AX, ret+16(FP)
+
+loop:
+	RET
+`,
+		},
+	}
+	for _, test := range tests {
+		got, err := FormatRange(bytes.NewBufferString(input), Options{}, test.ranges...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != test.want {
+			t.Errorf("%v: got:\n%s\nwant:\n%s", test.ranges, got, test.want)
+		}
+	}
+
+	for _, r := range []LineRange{{0, 1}, {3, 2}} {
+		if _, err := FormatRange(bytes.NewBufferString(input), Options{}, r); err == nil {
+			t.Errorf("%v: expected error", r)
+		}
+	}
+}
+
+// TestFormatRangeTestdata checks that formatting all lines
+// of the test files gives the same result as Format,
+// and that formatting a part does not change the final result.
+func TestFormatRangeTestdata(t *testing.T) {
+	match, err := filepath.Glob("testdata/*.in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, in := range match {
+		src, err := ioutil.ReadFile(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := Format(bytes.NewBuffer(src))
+		if err != nil {
+			t.Fatal(in, err)
+		}
+		n := len(splitLines(src))
+		got, err := FormatRange(bytes.NewBuffer(src), Options{}, LineRange{1, n})
+		if err != nil {
+			t.Fatal(in, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: formatting all lines differs from Format", in)
+		}
+
+		part, err := FormatRange(bytes.NewBuffer(src), Options{}, LineRange{n / 3, 2 * n / 3})
+		if err != nil {
+			t.Fatal(in, err)
+		}
+		got, err = Format(bytes.NewBuffer(part))
+		if err != nil {
+			t.Fatal(in, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: formatting part of the file changed the result", in)
+		}
+	}
+}
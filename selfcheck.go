@@ -0,0 +1,60 @@
+package asmfmt
+
+import (
+	"bytes"
+	"strings"
+)
+
+// selfCheck formats the output again with the same options,
+// and returns an error at the first line of the output that changes.
+func selfCheck(out []byte, opts Options) error {
+	opts.SelfCheck = false
+	// Warnings were reported when formatting the input.
+	opts.Warn = func(*Error) {}
+	again, err := FormatWithOptions(bytes.NewReader(out), opts)
+	if err != nil {
+		var list ErrorList
+		if l, ok := err.(ErrorList); ok {
+			for _, e := range l {
+				list.add(opts.Filename, e.Pos, KindNotIdempotent, "formatted output cannot be formatted again: %s", e.Msg)
+			}
+			return list
+		}
+		return err
+	}
+	pos, ok := firstChange(out, again)
+	if !ok {
+		return nil
+	}
+	a, b := lineAt(out, pos.Line), lineAt(again, pos.Line)
+	var list ErrorList
+	list.add(opts.Filename, pos, KindNotIdempotent, "formatting is not idempotent: %q becomes %q when formatted again", a, b)
+	return list
+}
+
+// firstChange returns the position of the first byte that differs between a and b.
+// The position is in a, and ok is false if a and b are equal.
+func firstChange(a, b []byte) (pos Pos, ok bool) {
+	if bytes.Equal(a, b) {
+		return Pos{}, false
+	}
+	pos = Pos{Line: 1, Column: 1}
+	for i := 0; i < len(a) && i < len(b) && a[i] == b[i]; i++ {
+		if a[i] == '\n' {
+			pos.Line++
+			pos.Column = 1
+			continue
+		}
+		pos.Column++
+	}
+	return pos, true
+}
+
+// lineAt returns line n of b without the newline, or "" if there is no such line.
+func lineAt(b []byte, n int) string {
+	lines := splitLines(b)
+	if n < 1 || n > len(lines) {
+		return ""
+	}
+	return strings.TrimSuffix(string(lines[n-1]), "\n")
+}
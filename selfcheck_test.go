@@ -0,0 +1,59 @@
+package asmfmt
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// Formatting the testdata files must be idempotent with all options.
+func TestSelfCheck(t *testing.T) {
+	match, err := filepath.Glob("testdata/*.in")
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := []Options{
+		{},
+		{KeepBlockComments: true, NoCommentSpace: true, NoCommentAlign: true},
+		{Indent: "    ", MaxBlankLines: -1, CommentColumn: 40},
+		{MaxBlankLines: 3, NormalizeMnemonics: true, NormalizeOperands: true, NormalizeNumbers: true, UpperHex: true, PadData: true},
+	}
+	for _, in := range match {
+		src, err := ioutil.ReadFile(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, opts := range options {
+			opts.Filename = in
+			opts.SelfCheck = true
+			if _, err := FormatWithOptions(bytes.NewReader(src), opts); err != nil {
+				t.Errorf("%+v: %v", opts, err)
+			}
+		}
+	}
+}
+
+func TestFirstChange(t *testing.T) {
+	tests := []struct {
+		a, b string
+		pos  Pos
+		line string
+	}{
+		{a: "a\nb\n", b: "a\nb\n"},
+		{a: "a\nb\n", b: "a\nc\n", pos: Pos{2, 1}, line: "b"},
+		{a: "\tMOVQ AX, BX\n", b: "\tMOVQ AX,BX\n", pos: Pos{1, 10}, line: "\tMOVQ AX, BX"},
+		{a: "a\n", b: "a\n\n", pos: Pos{2, 1}},
+		{a: "a\n\n", b: "a\n", pos: Pos{2, 1}},
+	}
+	for _, test := range tests {
+		pos, ok := firstChange([]byte(test.a), []byte(test.b))
+		if ok != (test.pos != Pos{}) || pos != test.pos {
+			t.Errorf("%q, %q: got %v, %v, want %v", test.a, test.b, pos, ok, test.pos)
+			continue
+		}
+		if line := lineAt([]byte(test.a), pos.Line); ok && line != test.line {
+			t.Errorf("%q: got line %q, want %q", test.a, line, test.line)
+		}
+	}
+}